@@ -1,7 +1,9 @@
 package aapi
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -29,13 +31,15 @@ type PaginatedAlertsResponse struct {
 
 // Alert represents an on-call alert.
 type Alert struct {
-	ID           string       `json:"id"`
-	AlertGroupID string       `json:"alert_group_id"`
-	CreatedAt    string       `json:"created_at"`
-	Payload      AlertPayload `json:"payload"`
+	ID           string `json:"id"`
+	AlertGroupID string `json:"alert_group_id"`
+	CreatedAt    string `json:"created_at"`
+	// Payload is the alert as sent by the monitoring system. Use DecodePayload
+	// or one of the typed adapters, such as AlertmanagerPayload, to read it.
+	Payload json.RawMessage `json:"payload"`
 }
 
-// AlertPayload represents an on-call alert payload.
+// AlertPayload represents a legacy Grafana alerting payload.
 type AlertPayload struct {
 	State       string           `json:"state"`
 	Title       string           `json:"title"`
@@ -56,17 +60,36 @@ type AlertEvalMatch struct {
 // ListAlertOptions represent filter options supported by the on-call alerts API.
 type ListAlertOptions struct {
 	ListOptions
-	AlertGroupID string `url:"alert_group_id,omitempty" json:"alert_group_id,omitempty"`
-	Name         string `url:"search,omitempty" json:"search,omitempty"`
+	AlertGroupID  string `url:"alert_group_id,omitempty" json:"alert_group_id,omitempty"`
+	Name          string `url:"search,omitempty" json:"search,omitempty"`
+	IntegrationID string `url:"integration_id,omitempty" json:"integration_id,omitempty"`
+	// CreatedAt is a time range in ISO 8601 format with start and end timestamps separated by underscore.
+	// Expected format: %Y-%m-%dT%H:%M:%S_%Y-%m-%dT%H:%M:%S
+	// Example: "2024-03-20T10:00:00_2024-03-21T10:00:00"
+	CreatedAt string `url:"created_at,omitempty" json:"created_at,omitempty"`
+}
+
+// Validate checks if the options are valid
+func (o *ListAlertOptions) Validate() error {
+	if err := validateTimeRange(o.CreatedAt); err != nil {
+		return err
+	}
+	return nil
 }
 
 // ListAlerts fetches all on-call alerts for authorized organization.
 //
 // https://grafana.com/docs/oncall/latest/oncall-api-reference/alerts/
 func (service *AlertService) ListAlerts(opt *ListAlertOptions) (*PaginatedAlertsResponse, *http.Response, error) {
+	if opt != nil {
+		if err := opt.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Alerts.ListAlerts", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,3 +102,35 @@ func (service *AlertService) ListAlerts(opt *ListAlertOptions) (*PaginatedAlerts
 
 	return alerts, resp, err
 }
+
+// ExportAlerts pages through all alerts matching opt and writes them to w as
+// newline-delimited JSON, one alert per line, a page at a time.
+// It returns the number of alerts written, also when failing midway.
+func (service *AlertService) ExportAlerts(w io.Writer, opt *ListAlertOptions) (int, *http.Response, error) {
+	// Paging through alerts changes opt.Page, leave the caller's options untouched.
+	pageOpt := ListAlertOptions{}
+	if opt != nil {
+		pageOpt = *opt
+	}
+	if err := pageOpt.Validate(); err != nil {
+		return 0, nil, err
+	}
+
+	written := 0
+	encoder := json.NewEncoder(w)
+	resp, err := forEachPage(&pageOpt.ListOptions, func() (*PaginatedResponse, *http.Response, error) {
+		page, resp, err := service.ListAlerts(&pageOpt)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, alert := range page.Alerts {
+			if err := encoder.Encode(alert); err != nil {
+				return nil, resp, fmt.Errorf("failed to write alert %s: %w", alert.ID, err)
+			}
+			written++
+		}
+		return &page.PaginatedResponse, resp, nil
+	})
+
+	return written, resp, err
+}
@@ -1,6 +1,7 @@
 package aapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -35,13 +36,46 @@ type AlertGroup struct {
 	ID             string            `json:"id"`
 	IntegrationID  string            `json:"integration_id"`
 	RouteID        string            `json:"route_id"`
+	TeamID         string            `json:"team_id"`
 	AlertsCount    int               `json:"alerts_count"`
 	State          string            `json:"state"`
 	CreatedAt      string            `json:"created_at"`
 	ResolvedAt     string            `json:"resolved_at"`
+	ResolvedBy     *AlertGroupUser   `json:"resolved_by"`
 	AcknowledgedAt string            `json:"acknowledged_at"`
+	AcknowledgedBy *AlertGroupUser   `json:"acknowledged_by"`
+	SilencedAt     string            `json:"silenced_at"`
 	Title          string            `json:"title"`
 	Permalinks     map[string]string `json:"permalinks"`
+	Labels         []*Label          `json:"labels"`
+	Teams          []*AlertGroupTeam `json:"teams"`
+	LastAlert      *Alert            `json:"last_alert"`
+}
+
+// AlertGroupUser is a user who acted on an alert group. Depending on the API version,
+// only ID is returned, as a plain string, or the full object.
+type AlertGroupUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+func (u *AlertGroupUser) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*u = AlertGroupUser{ID: id}
+		return nil
+	}
+
+	type alertGroupUser AlertGroupUser
+	return json.Unmarshal(data, (*alertGroupUser)(u))
+}
+
+// AlertGroupTeam is a team an alert group belongs to.
+type AlertGroupTeam struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	AvatarUrl string `json:"avatar_url,omitempty"`
 }
 
 // validateTimeRange validates if the time range string matches the expected format
@@ -103,6 +137,20 @@ type ListAlertGroupOptions struct {
 	// Example: ["env:prod", "severity:high"]
 	Labels []string `url:"label,omitempty" json:"label,omitempty"`
 	Name   string   `url:"name,omitempty" json:"name,omitempty"`
+	// User and escalation chain filters can be passed multiple times, matching any of the IDs.
+	AcknowledgedBy    []string `url:"acknowledged_by,omitempty" json:"acknowledged_by,omitempty"`
+	ResolvedBy        []string `url:"resolved_by,omitempty" json:"resolved_by,omitempty"`
+	SilencedBy        []string `url:"silenced_by,omitempty" json:"silenced_by,omitempty"`
+	InvolvedUsers     []string `url:"involved_users_are,omitempty" json:"involved_users_are,omitempty"`
+	EscalationChainID []string `url:"escalation_chain_id,omitempty" json:"escalation_chain_id,omitempty"`
+	Silenced          *bool    `url:"silenced,omitempty" json:"silenced,omitempty"`
+	// Mine limits results to alert groups the token user is involved in.
+	Mine bool `url:"mine,omitempty" json:"mine,omitempty"`
+	// IsRoot limits results to alert groups which are, or are not, attached to another alert group.
+	IsRoot *bool `url:"is_root,omitempty" json:"is_root,omitempty"`
+	// Ordering sorts results by given field, descending when prefixed with "-".
+	// Example: "-started_at"
+	Ordering string `url:"ordering,omitempty" json:"ordering,omitempty"`
 }
 
 // Validate checks if the options are valid
@@ -110,9 +158,14 @@ func (o *ListAlertGroupOptions) Validate() error {
 	if err := validateTimeRange(o.StartedAt); err != nil {
 		return err
 	}
+	if o.Ordering != "" && !orderingPattern.MatchString(o.Ordering) {
+		return fmt.Errorf("invalid ordering %q. Expected a field name, optionally prefixed with '-'", o.Ordering)
+	}
 	return nil
 }
 
+var orderingPattern = regexp.MustCompile(`^-?[a-z_]+$`)
+
 // ListAlertGroups fetches all on-call alerts for authorized organization.
 //
 // https://grafana.com/docs/oncall/latest/oncall-api-reference/alertgroups/
@@ -125,7 +178,7 @@ func (service *AlertGroupService) ListAlertGroups(opt *ListAlertGroupOptions) (*
 
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("AlertGroups.ListAlertGroups", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,7 +200,7 @@ func (service *AlertGroupService) GetAlertGroup(id string) (*AlertGroup, *http.R
 	sanitizedID := url.PathEscape(id)
 	u := fmt.Sprintf("%s/%s/", service.url, sanitizedID)
 
-	req, err := service.client.NewRequest("GET", u, nil)
+	req, err := service.client.NewRequest("GET", u, nil, WithOperation("AlertGroups.GetAlertGroup", id))
 	if err != nil {
 		return nil, nil, err
 	}
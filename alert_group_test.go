@@ -142,6 +142,20 @@ func TestListAlertGroupsValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "descending ordering",
+			options: &ListAlertGroupOptions{
+				Ordering: "-started_at",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ordering",
+			options: &ListAlertGroupOptions{
+				Ordering: "started_at,title",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -154,6 +168,8 @@ func TestListAlertGroupsValidation(t *testing.T) {
 	}
 }
 
+var testTrue, testFalse = true, false
+
 func TestListAlertGroupQueryURL(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -197,6 +213,26 @@ func TestListAlertGroupQueryURL(t *testing.T) {
 			},
 			expectedURL: "/api/v1/alert_groups/?label=cluster%3Aprod-eu-west-5&team_id=TMKSD2R5W9JFA",
 		},
+		{
+			name: "user filters",
+			options: &ListAlertGroupOptions{
+				AcknowledgedBy: []string{"U4DNY931HHJS5", "U6RV9WPSL6DFW"},
+				ResolvedBy:     []string{"U4DNY931HHJS5"},
+				InvolvedUsers:  []string{"U6RV9WPSL6DFW"},
+				Mine:           true,
+			},
+			expectedURL: "/api/v1/alert_groups/?acknowledged_by=U4DNY931HHJS5&acknowledged_by=U6RV9WPSL6DFW&involved_users_are=U6RV9WPSL6DFW&mine=true&resolved_by=U4DNY931HHJS5",
+		},
+		{
+			name: "silenced, root and escalation chain with ordering",
+			options: &ListAlertGroupOptions{
+				Silenced:          &testFalse,
+				IsRoot:            &testTrue,
+				EscalationChainID: []string{"FWDL7M6N6I9HE"},
+				Ordering:          "-started_at",
+			},
+			expectedURL: "/api/v1/alert_groups/?escalation_chain_id=FWDL7M6N6I9HE&is_root=true&ordering=-started_at&silenced=false",
+		},
 		{
 			name: "empty labels",
 			options: &ListAlertGroupOptions{
@@ -285,3 +321,56 @@ func TestGetAlertGroupWithSpecialCharacters(t *testing.T) {
 		t.Errorf("GetAlertGroup returned\n %+v, \nwant\n %+v", alertGroup, testAlertGroup)
 	}
 }
+
+func TestGetAlertGroupFullFields(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/alert_groups/I68T24C13IFW1/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": "I68T24C13IFW1",
+			"integration_id": "CFRPV98RPR1U8",
+			"route_id": "RIYGUJXCPFHXY",
+			"team_id": "T3HRAP3K3IKOP",
+			"alerts_count": 3,
+			"state": "resolved",
+			"created_at": "2020-05-19T12:37:01.430444Z",
+			"resolved_at": "2020-05-19T13:37:01.429805Z",
+			"resolved_by": "U4DNY931HHJS5",
+			"acknowledged_at": "2020-05-19T13:00:01.429805Z",
+			"acknowledged_by": {"id": "U6RV9WPSL6DFW", "username": "alex", "email": "alex@example.com"},
+			"silenced_at": null,
+			"title": "Memory above 90% threshold",
+			"permalinks": {},
+			"labels": [{"key": {"id": "KEY1", "name": "severity"}, "value": {"id": "VAL1", "name": "critical"}}],
+			"teams": [{"id": "T3HRAP3K3IKOP", "name": "SRE", "avatar_url": "https://example.com/avatar"}],
+			"last_alert": {"id": "AA74DN7T4JQB6", "alert_group_id": "I68T24C13IFW1", "created_at": "2020-05-19T13:37:01.429805Z", "payload": {"state": "alerting"}}
+		}`)
+	})
+
+	alertGroup, _, err := client.AlertGroups.GetAlertGroup("I68T24C13IFW1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (&AlertGroupUser{ID: "U4DNY931HHJS5"}); !reflect.DeepEqual(want, alertGroup.ResolvedBy) {
+		t.Errorf("resolved_by = %+v, want %+v", alertGroup.ResolvedBy, want)
+	}
+	if want := (&AlertGroupUser{ID: "U6RV9WPSL6DFW", Username: "alex", Email: "alex@example.com"}); !reflect.DeepEqual(want, alertGroup.AcknowledgedBy) {
+		t.Errorf("acknowledged_by = %+v, want %+v", alertGroup.AcknowledgedBy, want)
+	}
+	if want := []*AlertGroupTeam{{ID: "T3HRAP3K3IKOP", Name: "SRE", AvatarUrl: "https://example.com/avatar"}}; !reflect.DeepEqual(want, alertGroup.Teams) {
+		t.Errorf("teams = %+v, want %+v", alertGroup.Teams, want)
+	}
+	wantLabels := []*Label{{Key: KeyValueName{ID: "KEY1", Name: "severity"}, Value: KeyValueName{ID: "VAL1", Name: "critical"}}}
+	if !reflect.DeepEqual(wantLabels, alertGroup.Labels) {
+		t.Errorf("labels = %+v, want %+v", alertGroup.Labels, wantLabels)
+	}
+	if alertGroup.TeamID != "T3HRAP3K3IKOP" || alertGroup.SilencedAt != "" {
+		t.Errorf("unexpected team_id %q or silenced_at %q", alertGroup.TeamID, alertGroup.SilencedAt)
+	}
+	if alertGroup.LastAlert == nil || alertGroup.LastAlert.ID != "AA74DN7T4JQB6" {
+		t.Errorf("last_alert = %+v", alertGroup.LastAlert)
+	}
+}
@@ -0,0 +1,101 @@
+package aapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AlertmanagerPayload is the payload of alerts sent by Prometheus Alertmanager.
+type AlertmanagerPayload struct {
+	Receiver          string              `json:"receiver"`
+	Status            string              `json:"status"`
+	Alerts            []AlertmanagerAlert `json:"alerts"`
+	GroupLabels       map[string]string   `json:"groupLabels"`
+	CommonLabels      map[string]string   `json:"commonLabels"`
+	CommonAnnotations map[string]string   `json:"commonAnnotations"`
+	ExternalURL       string              `json:"externalURL"`
+	Version           string              `json:"version"`
+	GroupKey          string              `json:"groupKey"`
+	TruncatedAlerts   int                 `json:"truncatedAlerts"`
+}
+
+// AlertmanagerAlert is a single alert of an Alertmanager notification.
+type AlertmanagerAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     string            `json:"startsAt"`
+	EndsAt       string            `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// GrafanaUnifiedPayload is the payload of alerts sent by Grafana Alerting.
+// It extends the Alertmanager payload with Grafana specific fields.
+type GrafanaUnifiedPayload struct {
+	AlertmanagerPayload
+	Alerts  []GrafanaUnifiedAlert `json:"alerts"`
+	OrgID   int                   `json:"orgId"`
+	State   string                `json:"state"`
+	Title   string                `json:"title"`
+	Message string                `json:"message"`
+}
+
+// GrafanaUnifiedAlert is a single alert of a Grafana Alerting notification.
+type GrafanaUnifiedAlert struct {
+	AlertmanagerAlert
+	Values       map[string]float64 `json:"values"`
+	ValueString  string             `json:"valueString"`
+	SilenceURL   string             `json:"silenceURL"`
+	DashboardURL string             `json:"dashboardURL"`
+	PanelURL     string             `json:"panelURL"`
+}
+
+// DecodePayload decodes the alert payload into v, e.g. a struct matching
+// the payload of a custom integration.
+func (a *Alert) DecodePayload(v interface{}) error {
+	if len(a.Payload) == 0 {
+		return fmt.Errorf("alert %s has no payload", a.ID)
+	}
+	if err := json.Unmarshal(a.Payload, v); err != nil {
+		return fmt.Errorf("failed to decode payload of alert %s: %w", a.ID, err)
+	}
+	return nil
+}
+
+// AlertmanagerPayload decodes the payload of an alert from an Alertmanager integration.
+func (a *Alert) AlertmanagerPayload() (*AlertmanagerPayload, error) {
+	payload := new(AlertmanagerPayload)
+	if err := a.DecodePayload(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GrafanaUnifiedPayload decodes the payload of an alert from a Grafana Alerting integration.
+func (a *Alert) GrafanaUnifiedPayload() (*GrafanaUnifiedPayload, error) {
+	payload := new(GrafanaUnifiedPayload)
+	if err := a.DecodePayload(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GrafanaLegacyPayload decodes the payload of an alert from a legacy Grafana alerting integration.
+func (a *Alert) GrafanaLegacyPayload() (*AlertPayload, error) {
+	payload := new(AlertPayload)
+	if err := a.DecodePayload(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// WebhookPayload decodes the payload of an alert from a generic webhook integration,
+// which can be any JSON object.
+func (a *Alert) WebhookPayload() (map[string]interface{}, error) {
+	var payload map[string]interface{}
+	if err := a.DecodePayload(&payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -1,9 +1,12 @@
 package aapi
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -28,18 +31,7 @@ var payload = AlertPayload{
 	EvalMatches: evalMatches,
 }
 
-var testAlert = &Alert{
-	ID:           "OH3V5FYQEYJ6M",
-	AlertGroupID: "T3HRAP3K3IKOP",
-	CreatedAt:    "2020-05-11T20:07:43Z",
-	Payload:      payload,
-}
-
-var testAlertBody = `{
-	"id": "OH3V5FYQEYJ6M",
-	"alert_group_id": "T3HRAP3K3IKOP",
-	"created_at": "2020-05-11T20:07:43Z",
-	"payload": {
+var testAlertPayloadBody = `{
 		"state": "alerting",
 		"title": "[Alerting] Test notification",
 		"ruleId": 0,
@@ -58,8 +50,21 @@ var testAlertBody = `{
 				"metric": "Higher value"
 			}
 		]
-	}
-}`
+	}`
+
+var testAlert = &Alert{
+	ID:           "OH3V5FYQEYJ6M",
+	AlertGroupID: "T3HRAP3K3IKOP",
+	CreatedAt:    "2020-05-11T20:07:43Z",
+	Payload:      json.RawMessage(testAlertPayloadBody),
+}
+
+var testAlertBody = fmt.Sprintf(`{
+	"id": "OH3V5FYQEYJ6M",
+	"alert_group_id": "T3HRAP3K3IKOP",
+	"created_at": "2020-05-11T20:07:43Z",
+	"payload": %s
+}`, testAlertPayloadBody)
 
 func TestListAlerts(t *testing.T) {
 	mux, server, client := setup(t)
@@ -96,3 +101,211 @@ func TestListAlerts(t *testing.T) {
 		t.Errorf(" returned\n %+v, \nwant\n %+v", alerts, want)
 	}
 }
+
+func TestListAlertsFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/alerts/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		query := r.URL.Query()
+		if got := query.Get("integration_id"); got != "CFRPV98RPR1U8" {
+			t.Errorf("integration_id = %q, want %q", got, "CFRPV98RPR1U8")
+		}
+		if got := query.Get("created_at"); got != "2024-03-20T10:00:00_2024-03-21T10:00:00" {
+			t.Errorf("created_at = %q, want %q", got, "2024-03-20T10:00:00_2024-03-21T10:00:00")
+		}
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+
+	options := &ListAlertOptions{
+		IntegrationID: "CFRPV98RPR1U8",
+		CreatedAt:     "2024-03-20T10:00:00_2024-03-21T10:00:00",
+	}
+	if _, _, err := client.Alerts.ListAlerts(options); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Alerts.ListAlerts(&ListAlertOptions{CreatedAt: "2024-03-20"}); err == nil {
+		t.Error("expected error for invalid created_at")
+	}
+}
+
+func TestExportAlerts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/alerts/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("integration_id"); got != "CFRPV98RPR1U8" {
+			t.Errorf("integration_id = %q, want %q", got, "CFRPV98RPR1U8")
+		}
+		switch page := r.URL.Query().Get("page"); page {
+		case "1":
+			fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": "%s/api/v1/alerts/?page=2", "previous": null, "results": [%s]}`, server.URL, testAlertBody))
+		case "2":
+			fmt.Fprint(w, `{"count": 2, "next": null, "previous": null, "results": [{"id": "AV2KSQEMUX2PB", "alert_group_id": "T3HRAP3K3IKOP", "created_at": "2020-05-11T20:08:43Z", "payload": {}}]}`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	options := &ListAlertOptions{IntegrationID: "CFRPV98RPR1U8"}
+	var buf bytes.Buffer
+	written, _, err := client.Alerts.ExportAlerts(&buf, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != 2 {
+		t.Errorf("written = %d, want 2", written)
+	}
+	if options.Page != 0 {
+		t.Errorf("caller options page changed to %d", options.Page)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	var first Alert
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first.ID != testAlert.ID || first.AlertGroupID != testAlert.AlertGroupID {
+		t.Errorf("returned\n %+v\n want\n %+v\n", first, testAlert)
+	}
+	var second Alert
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatal(err)
+	}
+	if second.ID != "AV2KSQEMUX2PB" {
+		t.Errorf("second alert ID = %q, want %q", second.ID, "AV2KSQEMUX2PB")
+	}
+}
+
+func TestAlertGrafanaLegacyPayload(t *testing.T) {
+	got, err := testAlert.GrafanaLegacyPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&payload, got) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", got, &payload)
+	}
+}
+
+func TestAlertAlertmanagerPayload(t *testing.T) {
+	alert := &Alert{ID: "OH3V5FYQEYJ6M", Payload: json.RawMessage(`{
+		"receiver": "oncall",
+		"status": "firing",
+		"alerts": [{
+			"status": "firing",
+			"labels": {"alertname": "HighMemory", "severity": "critical"},
+			"annotations": {"summary": "Memory above 90%"},
+			"startsAt": "2020-05-11T20:07:43Z",
+			"endsAt": "0001-01-01T00:00:00Z",
+			"generatorURL": "http://prometheus/graph",
+			"fingerprint": "c6eadffa33f2a7e0"
+		}],
+		"groupLabels": {"alertname": "HighMemory"},
+		"commonLabels": {"alertname": "HighMemory", "severity": "critical"},
+		"commonAnnotations": {"summary": "Memory above 90%"},
+		"externalURL": "http://alertmanager",
+		"version": "4",
+		"groupKey": "{}:{alertname=\"HighMemory\"}",
+		"truncatedAlerts": 0
+	}`)}
+
+	got, err := alert.AlertmanagerPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &AlertmanagerPayload{
+		Receiver: "oncall",
+		Status:   "firing",
+		Alerts: []AlertmanagerAlert{{
+			Status:       "firing",
+			Labels:       map[string]string{"alertname": "HighMemory", "severity": "critical"},
+			Annotations:  map[string]string{"summary": "Memory above 90%"},
+			StartsAt:     "2020-05-11T20:07:43Z",
+			EndsAt:       "0001-01-01T00:00:00Z",
+			GeneratorURL: "http://prometheus/graph",
+			Fingerprint:  "c6eadffa33f2a7e0",
+		}},
+		GroupLabels:       map[string]string{"alertname": "HighMemory"},
+		CommonLabels:      map[string]string{"alertname": "HighMemory", "severity": "critical"},
+		CommonAnnotations: map[string]string{"summary": "Memory above 90%"},
+		ExternalURL:       "http://alertmanager",
+		Version:           "4",
+		GroupKey:          `{}:{alertname="HighMemory"}`,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", got, want)
+	}
+}
+
+func TestAlertGrafanaUnifiedPayload(t *testing.T) {
+	alert := &Alert{ID: "OH3V5FYQEYJ6M", Payload: json.RawMessage(`{
+		"receiver": "oncall",
+		"status": "firing",
+		"orgId": 1,
+		"state": "alerting",
+		"title": "[FIRING:1] HighMemory",
+		"message": "Memory above 90%",
+		"alerts": [{
+			"status": "firing",
+			"labels": {"alertname": "HighMemory"},
+			"values": {"B": 93.5},
+			"valueString": "[ var='B' value=93.5 ]",
+			"dashboardURL": "http://grafana/d/abc",
+			"fingerprint": "c6eadffa33f2a7e0"
+		}]
+	}`)}
+
+	got, err := alert.GrafanaUnifiedPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.OrgID != 1 || got.State != "alerting" || got.Receiver != "oncall" || len(got.Alerts) != 1 {
+		t.Fatalf("returned %+v", got)
+	}
+	alertmanagerAlert := AlertmanagerAlert{Status: "firing", Labels: map[string]string{"alertname": "HighMemory"}, Fingerprint: "c6eadffa33f2a7e0"}
+	wantAlert := GrafanaUnifiedAlert{
+		AlertmanagerAlert: alertmanagerAlert,
+		Values:            map[string]float64{"B": 93.5},
+		ValueString:       "[ var='B' value=93.5 ]",
+		DashboardURL:      "http://grafana/d/abc",
+	}
+	if !reflect.DeepEqual(wantAlert, got.Alerts[0]) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", got.Alerts[0], wantAlert)
+	}
+}
+
+func TestAlertWebhookAndCustomPayload(t *testing.T) {
+	alert := &Alert{ID: "OH3V5FYQEYJ6M", Payload: json.RawMessage(`{"monitor": "api-latency", "priority": "P1", "tags": ["api"]}`)}
+
+	webhook, err := alert.WebhookPayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook["monitor"] != "api-latency" {
+		t.Errorf("returned %+v", webhook)
+	}
+
+	var custom struct {
+		Monitor  string   `json:"monitor"`
+		Priority string   `json:"priority"`
+		Tags     []string `json:"tags"`
+	}
+	if err := alert.DecodePayload(&custom); err != nil {
+		t.Fatal(err)
+	}
+	if custom.Priority != "P1" || !reflect.DeepEqual([]string{"api"}, custom.Tags) {
+		t.Errorf("returned %+v", custom)
+	}
+
+	if err := (&Alert{ID: "OH3V5FYQEYJ6M"}).DecodePayload(&custom); err == nil {
+		t.Error("expected error for missing payload")
+	}
+}
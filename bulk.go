@@ -0,0 +1,151 @@
+package aapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BulkMode defines how BulkExecutor reacts to failed operations.
+type BulkMode int
+
+const (
+	// BulkBestEffort runs every operation regardless of failures.
+	BulkBestEffort BulkMode = iota
+	// BulkStopOnError stops starting new operations after the first failure.
+	BulkStopOnError
+)
+
+// BulkOperation is a single service call run by BulkExecutor, e.g.
+//
+//	BulkOperation{ID: routeID, Do: func() error {
+//		_, _, err := client.Routes.UpdateRoute(routeID, opt)
+//		return err
+//	}}
+type BulkOperation struct {
+	ID string
+	Do func() error
+}
+
+// BulkResult is the outcome of a single operation.
+// Skipped operations were never started because the run was stopped.
+type BulkResult struct {
+	ID       string
+	Err      error
+	Skipped  bool
+	Duration time.Duration
+}
+
+// BulkReport holds results of all operations in the order they were given to Run.
+type BulkReport struct {
+	Results []BulkResult
+}
+
+// Succeeded returns the number of operations which completed without error.
+func (r *BulkReport) Succeeded() int {
+	n := 0
+	for _, result := range r.Results {
+		if !result.Skipped && result.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns results of operations which returned an error.
+func (r *BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Skipped returns results of operations which were not started.
+func (r *BulkReport) Skipped() []BulkResult {
+	var skipped []BulkResult
+	for _, result := range r.Results {
+		if result.Skipped {
+			skipped = append(skipped, result)
+		}
+	}
+	return skipped
+}
+
+// Err joins errors of all failed operations, or returns nil if none failed.
+func (r *BulkReport) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", result.ID, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// BulkExecutor runs many service calls with bounded parallelism.
+// Operations go through the client as usual, so the client rate limiter,
+// set with SetRateLimiter, throttles them across all workers.
+type BulkExecutor struct {
+	workers int
+	mode    BulkMode
+}
+
+// NewBulkExecutor creates BulkExecutor running up to workers operations at once.
+func NewBulkExecutor(workers int, mode BulkMode) *BulkExecutor {
+	if workers < 1 {
+		workers = 1
+	}
+	return &BulkExecutor{workers: workers, mode: mode}
+}
+
+// Run executes operations and waits for all started ones to finish. Operations not
+// started when ctx is done, or after a failure in BulkStopOnError mode, are skipped.
+func (e *BulkExecutor) Run(ctx context.Context, operations []BulkOperation) *BulkReport {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	report := &BulkReport{Results: make([]BulkResult, len(operations))}
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < e.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				operation := operations[index]
+				if ctx.Err() != nil {
+					report.Results[index] = BulkResult{ID: operation.ID, Skipped: true}
+					continue
+				}
+				start := time.Now()
+				err := operation.Do()
+				report.Results[index] = BulkResult{ID: operation.ID, Err: err, Duration: time.Since(start)}
+				if err != nil && e.mode == BulkStopOnError {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < len(operations); next++ {
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for ; next < len(operations); next++ {
+		report.Results[next] = BulkResult{ID: operations[next].ID, Skipped: true}
+	}
+
+	return report
+}
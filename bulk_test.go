@@ -0,0 +1,115 @@
+package aapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulkExecutorBestEffort(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var mu sync.Mutex
+	updated := map[string]string{}
+	mux.HandleFunc("/api/v1/routes/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "PUT")
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/routes/"), "/")[0]
+		if id == "RBROKEN" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"detail": "error"}`)
+			return
+		}
+		mu.Lock()
+		updated[id] = "E5JJTU52M5YM4"
+		mu.Unlock()
+		fmt.Fprint(w, `{"id": "`+id+`", "escalation_chain_id": "E5JJTU52M5YM4"}`)
+	})
+
+	var operations []BulkOperation
+	for _, id := range []string{"R1", "R2", "RBROKEN", "R3"} {
+		id := id
+		operations = append(operations, BulkOperation{ID: id, Do: func() error {
+			_, _, err := client.Routes.UpdateRoute(id, &UpdateRouteOptions{EscalationChainId: "E5JJTU52M5YM4"})
+			return err
+		}})
+	}
+
+	report := NewBulkExecutor(2, BulkBestEffort).Run(context.Background(), operations)
+
+	if report.Succeeded() != 3 || len(updated) != 3 {
+		t.Errorf("Expected 3 routes to be updated, got %d", report.Succeeded())
+	}
+	failed := report.Failed()
+	if len(failed) != 1 || failed[0].ID != "RBROKEN" {
+		t.Errorf("Unexpected failures: %+v", failed)
+	}
+	if err := report.Err(); err == nil || !strings.HasPrefix(err.Error(), "RBROKEN: ") {
+		t.Errorf("Unexpected report error: %v", err)
+	}
+}
+
+func TestBulkExecutorStopOnError(t *testing.T) {
+	var started int32
+	errFailed := errors.New("failed")
+
+	var operations []BulkOperation
+	for i := 0; i < 10; i++ {
+		i := i
+		operations = append(operations, BulkOperation{ID: fmt.Sprint(i), Do: func() error {
+			atomic.AddInt32(&started, 1)
+			if i == 0 {
+				return errFailed
+			}
+			time.Sleep(time.Millisecond)
+			return nil
+		}})
+	}
+
+	report := NewBulkExecutor(1, BulkStopOnError).Run(context.Background(), operations)
+
+	if started != 1 {
+		t.Errorf("Expected only the first operation to start, %d started", started)
+	}
+	if len(report.Skipped()) != 9 {
+		t.Errorf("Expected 9 skipped operations, got %d", len(report.Skipped()))
+	}
+	if !errors.Is(report.Err(), errFailed) {
+		t.Errorf("Expected report error to wrap operation error, got %v", report.Err())
+	}
+}
+
+func TestBulkExecutorParallelism(t *testing.T) {
+	var running, maxRunning int32
+
+	var operations []BulkOperation
+	for i := 0; i < 12; i++ {
+		operations = append(operations, BulkOperation{ID: fmt.Sprint(i), Do: func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		}})
+	}
+
+	report := NewBulkExecutor(3, BulkBestEffort).Run(context.Background(), operations)
+
+	if report.Succeeded() != 12 {
+		t.Errorf("Expected 12 operations to succeed, got %d", report.Succeeded())
+	}
+	if maxRunning > 3 {
+		t.Errorf("Expected at most 3 concurrent operations, got %d", maxRunning)
+	}
+}
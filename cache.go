@@ -0,0 +1,215 @@
+package aapi
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// cacheHeader is set on responses served from the cache.
+const cacheHeader = "X-From-Cache"
+
+// CacheEntry is a cached response body together with its validators.
+type CacheEntry struct {
+	ETag         string
+	LastModified string
+	Header       http.Header
+	Body         []byte
+}
+
+// CacheStore keeps cached responses by request URL.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	// DeletePrefix removes all entries with keys starting with prefix.
+	DeletePrefix(prefix string)
+}
+
+// LRUCache is an in-memory CacheStore which keeps up to size most recently used
+// entries, each for at most ttl. Zero ttl means entries never expire.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruItem struct {
+	key      string
+	entry    *CacheEntry
+	storedAt time.Time
+}
+
+// NewLRUCache creates an empty LRUCache.
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns a not expired entry stored for key.
+func (c *LRUCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	item := element.Value.(*lruItem)
+	if c.ttl > 0 && time.Since(item.storedAt) > c.ttl {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return item.entry, true
+}
+
+// Set stores entry for key, evicting the least recently used entry when the cache is full.
+func (c *LRUCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		element.Value = &lruItem{key: key, entry: entry, storedAt: time.Now()}
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruItem{key: key, entry: entry, storedAt: time.Now()})
+	for c.size > 0 && c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+// DeletePrefix removes all entries with keys starting with prefix.
+func (c *LRUCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.order.Remove(element)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// SetCache enables conditional requests for GET calls. Responses with ETag or
+// Last-Modified headers are stored in given store, later requests for the same URL
+// send If-None-Match and If-Modified-Since, and 304 answers are served from the store.
+// Successful create, update and delete calls invalidate entries of the same service.
+// Passing nil disables caching.
+func (c *Client) SetCache(store CacheStore) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache = store
+}
+
+// cachingDoer wraps next with the response cache.
+func (c *Client) cachingDoer(store CacheStore, next Doer) Doer {
+	return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			resp, err := next.Do(req)
+			if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				store.DeletePrefix(c.servicePrefix(req))
+			}
+			return resp, err
+		}
+
+		key := cacheKey(req)
+		entry, cached := store.Get(key)
+		if cached {
+			if entry.ETag != "" {
+				req.Header.Set("If-None-Match", entry.ETag)
+			}
+			if entry.LastModified != "" {
+				req.Header.Set("If-Modified-Since", entry.LastModified)
+			}
+		}
+
+		resp, err := next.Do(req)
+		if err != nil {
+			return resp, err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusNotModified && cached:
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+
+			header := entry.Header.Clone()
+			if header == nil {
+				header = make(http.Header)
+			}
+			header.Set(cacheHeader, "1")
+			return &http.Response{
+				Status:        "200 OK",
+				StatusCode:    http.StatusOK,
+				Proto:         resp.Proto,
+				ProtoMajor:    resp.ProtoMajor,
+				ProtoMinor:    resp.ProtoMinor,
+				Header:        header,
+				Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+				ContentLength: int64(len(entry.Body)),
+				Request:       resp.Request,
+			}, nil
+
+		case resp.StatusCode == http.StatusOK:
+			etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+			if etag == "" && lastModified == "" {
+				return resp, nil
+			}
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return resp, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			store.Set(key, &CacheEntry{
+				ETag:         etag,
+				LastModified: lastModified,
+				Header:       resp.Header.Clone(),
+				Body:         body,
+			})
+		}
+
+		return resp, nil
+	})
+}
+
+// cacheKey returns the URL of the request with a trailing slash added to the path,
+// so that keys of all URLs of a service start with its servicePrefix.
+func cacheKey(req *retryablehttp.Request) string {
+	u := *req.URL
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path, u.RawPath = u.Path+"/", ""
+	}
+	return u.String()
+}
+
+// servicePrefix returns the cache key prefix shared by all URLs of the service
+// the request was sent to, e.g. "https://oncall/api/v1/integrations/". The trailing
+// slash keeps services whose names share a prefix, like escalation and escalation_chains, apart.
+func (c *Client) servicePrefix(req *retryablehttp.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.baseURL.Path)
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	u := *req.URL
+	u.Path, u.RawPath, u.RawQuery = c.baseURL.Path+path+"/", "", ""
+	return u.String()
+}
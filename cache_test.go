@@ -0,0 +1,161 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCacheNotModified(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	requests := 0
+	mux.HandleFunc("/api/v1/users/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testUserBody))
+	})
+
+	client.SetCache(NewLRUCache(10, time.Minute))
+
+	first, _, err := client.Users.ListUsers(&ListUserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, resp, err := client.Users.ListUsers(&ListUserOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if resp.Header.Get(cacheHeader) != "1" {
+		t.Error("Expected second response to be served from cache")
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Cached response\n %+v, \nwant\n %+v", second, first)
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	conditional := 0
+	mux.HandleFunc("/api/v1/schedules/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{"id": "SBM7DV7BKFUYU"}`)
+			return
+		}
+		w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		if r.Header.Get("If-Modified-Since") != "" {
+			conditional++
+		}
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+
+	client.SetCache(NewLRUCache(10, 0))
+
+	if _, _, err := client.Schedules.ListSchedules(&ListScheduleOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Schedules.CreateSchedule(&CreateScheduleOptions{Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Schedules.ListSchedules(&ListScheduleOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if conditional != 0 {
+		t.Errorf("Expected cache to be invalidated by CreateSchedule, got %d conditional requests", conditional)
+	}
+}
+
+func TestCacheInvalidationSharedPrefix(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	conditional := map[string]int{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") != "" {
+			conditional[r.URL.Path]++
+		}
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	}
+	mux.HandleFunc("/api/v1/escalation/", handler)
+	mux.HandleFunc("/api/v1/escalation_chains/", handler)
+	mux.HandleFunc("/api/v1/escalation_policies", handler)
+	mux.HandleFunc("/api/v1/escalation_policies/", handler)
+
+	client.SetCache(NewLRUCache(10, 0))
+
+	do := func(method, path string) {
+		req, err := client.NewRequest(method, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	do("GET", "escalation_chains/")
+	do("GET", "escalation_policies")
+	do("POST", "escalation/")
+	do("POST", "escalation_policies/")
+	do("GET", "escalation_chains/")
+	do("GET", "escalation_policies")
+
+	if conditional["/api/v1/escalation_chains/"] != 1 {
+		t.Errorf("Expected escalation_chains to stay cached after a call to escalation, got %d conditional requests", conditional["/api/v1/escalation_chains/"])
+	}
+	if conditional["/api/v1/escalation_policies"] != 0 {
+		t.Errorf("Expected escalation_policies to be invalidated, got %d conditional requests", conditional["/api/v1/escalation_policies"])
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2, 0)
+	cache.Set("a", &CacheEntry{ETag: "a"})
+	cache.Set("b", &CacheEntry{ETag: "b"})
+	cache.Get("a")
+	cache.Set("c", &CacheEntry{ETag: "c"})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := cache.Get(key); !ok {
+			t.Errorf("Expected entry %s to be cached", key)
+		}
+	}
+
+	cache.DeletePrefix("a")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected entry a to be deleted")
+	}
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	cache := NewLRUCache(2, time.Millisecond)
+	cache.Set("a", &CacheEntry{ETag: "a"})
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected entry to expire")
+	}
+}
@@ -0,0 +1,203 @@
+package aapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// CassetteMode defines whether a Cassette talks to the API.
+type CassetteMode int
+
+const (
+	// CassetteReplay answers requests from the cassette file only.
+	// Unknown requests get a 501 Not Implemented response.
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests to the API and records them, overwriting the cassette file on Save.
+	CassetteRecord
+)
+
+// CassetteRequest is the part of a recorded request used for matching.
+// The Authorization header is never recorded.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// CassetteInteraction is a recorded request/response pair.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette is an http.RoundTripper which records API interactions to a file
+// and replays them offline. Requests match on method, path, query and JSON body
+// normalized to sorted keys. Credentials such as webhook passwords and authorization
+// headers are scrubbed from recorded bodies.
+//
+//	cassette, err := aapi.NewCassette("testdata/integrations.json", aapi.CassetteReplay, nil)
+//	client.SetTransport(cassette)
+type Cassette struct {
+	path string
+	mode CassetteMode
+	base http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*CassetteInteraction
+	replayed     []bool
+}
+
+// NewCassette creates a Cassette backed by the file at path. In replay mode the file
+// is loaded immediately. In record mode requests are sent with base, or with
+// http.DefaultTransport when base is nil.
+func NewCassette(path string, mode CassetteMode, base http.RoundTripper) (*Cassette, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c := &Cassette{path: path, mode: mode, base: base}
+
+	if mode == CassetteReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &c.interactions); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		c.replayed = make([]bool, len(c.interactions))
+	}
+
+	return c, nil
+}
+
+// RoundTrip records or replays a single request.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := CassetteRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Body:   normalizeBody(body),
+	}
+
+	if c.mode == CassetteReplay {
+		return c.replay(req, recorded)
+	}
+	return c.record(req, recorded)
+}
+
+func (c *Cassette) replay(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Prefer interactions not replayed yet, so the same request can get different responses,
+	// and fall back to the last matching one for requests repeated more often than recorded.
+	match := -1
+	for i, interaction := range c.interactions {
+		if interaction.Request != recorded {
+			continue
+		}
+		match = i
+		if !c.replayed[i] {
+			break
+		}
+	}
+	if match < 0 {
+		// 501 is the one server error retryablehttp does not retry.
+		detail, _ := json.Marshal(map[string]string{
+			"detail": fmt.Sprintf("cassette %s has no interaction for %s %s", c.path, req.Method, req.URL.Path),
+		})
+		return newCassetteResponse(req, CassetteResponse{StatusCode: http.StatusNotImplemented, Body: string(detail)}), nil
+	}
+	c.replayed[match] = true
+
+	return newCassetteResponse(req, c.interactions[match].Response), nil
+}
+
+func newCassetteResponse(req *http.Request, recorded CassetteResponse) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+func (c *Cassette) record(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	resp, err := c.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, &CassetteInteraction{
+		Request: recorded,
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       normalizeBody(body),
+		},
+	})
+
+	return resp, nil
+}
+
+// Save writes recorded interactions to the cassette file. It does nothing in replay mode.
+func (c *Cassette) Save() error {
+	if c.mode == CassetteReplay {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// normalizeBody scrubs sensitive fields from a JSON body, see redactBody.
+func normalizeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	return redactBody(body)
+}
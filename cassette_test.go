@@ -0,0 +1,118 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	mux, server, client := setup(t)
+
+	mux.HandleFunc("/api/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{"id": "KGEFG74LU1D8L", "name": "Test Webhook", "password": "secret", "authorization_header": "Bearer secret"}`)
+			return
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testWebhookBody))
+	})
+
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	recorder, err := NewCassette(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTransport(recorder)
+
+	createOptions := &CreateWebhookOptions{Name: "Test Webhook", Password: NewSecret("secret")}
+	created, _, err := client.Webhooks.CreateWebhook(createOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed, _, err := client.Webhooks.ListWebhooks(&ListWebhookOptions{Name: "Test action"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	teardown(server)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret") || strings.Contains(string(data), "token") {
+		t.Errorf("Cassette contains credentials:\n%s", data)
+	}
+
+	replayClient, err := New(server.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	player, err := NewCassette(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayClient.SetTransport(player)
+
+	replayed, _, err := replayClient.Webhooks.ListWebhooks(&ListWebhookOptions{Name: "Test action"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(listed, replayed) {
+		t.Errorf("Replayed\n %+v, \nwant\n %+v", replayed, listed)
+	}
+
+	replayedWebhook, _, err := replayClient.Webhooks.CreateWebhook(createOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayedWebhook.ID != created.ID || replayedWebhook.Password.Value() != redacted {
+		t.Errorf("Unexpected replayed webhook %+v", replayedWebhook)
+	}
+
+	_, resp, err := replayClient.Webhooks.ListWebhooks(&ListWebhookOptions{Name: "other"})
+	if err == nil || resp.StatusCode != http.StatusNotImplemented {
+		t.Errorf("Expected 501 error for request missing from the cassette, got %v", err)
+	}
+}
+
+func TestCassetteKeepsNumbers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/test/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 12345678901234567891, "v": 1.0}`)
+	})
+
+	path := filepath.Join(t.TempDir(), "numbers.json")
+	recorder, err := NewCassette(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTransport(recorder)
+
+	req, err := client.NewRequest("GET", "test/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `{\"id\": 12345678901234567891, \"v\": 1.0}`) {
+		t.Errorf("Cassette does not keep the response body as sent:\n%s", data)
+	}
+}
@@ -0,0 +1,99 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ChatOpsPlatform is a chat platform routes can post alert groups to.
+type ChatOpsPlatform string
+
+const (
+	ChatOpsSlack    ChatOpsPlatform = "slack"
+	ChatOpsTelegram ChatOpsPlatform = "telegram"
+	ChatOpsMSTeams  ChatOpsPlatform = "msteams"
+)
+
+// ChatOpsChannel is a channel of any supported chat platform. ID is the identifier
+// routes expect: the Slack ID for Slack, the OnCall channel ID for Telegram and MS Teams.
+type ChatOpsChannel struct {
+	Platform ChatOpsPlatform
+	ID       string
+	Name     string
+}
+
+// ResolveChatOpsChannel looks up the channel with exactly the given name on the platform.
+func (c *Client) ResolveChatOpsChannel(platform ChatOpsPlatform, name string) (*ChatOpsChannel, *http.Response, error) {
+	switch platform {
+	case ChatOpsSlack:
+		channel, resp, err := c.SlackChannels.GetByName(name)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &ChatOpsChannel{Platform: platform, ID: channel.SlackId, Name: channel.Name}, resp, nil
+	case ChatOpsTelegram:
+		channel, resp, err := c.TelegramChannels.GetByName(name)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &ChatOpsChannel{Platform: platform, ID: channel.ID, Name: channel.ChannelName}, resp, nil
+	case ChatOpsMSTeams:
+		channel, resp, err := c.MSTeamsChannels.GetByName(name)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &ChatOpsChannel{Platform: platform, ID: channel.ID, Name: channel.Name}, resp, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported chat-ops platform %q", platform)
+}
+
+// routes returns the route settings enabling the channel.
+// Only the one matching the channel platform is not nil.
+func (ch *ChatOpsChannel) routes() (*SlackRoute, *TelegramRoute, *MSTeamsRoute, error) {
+	id := ch.ID
+	switch ch.Platform {
+	case ChatOpsSlack:
+		return &SlackRoute{ChannelId: &id, Enabled: true}, nil, nil, nil
+	case ChatOpsTelegram:
+		return nil, &TelegramRoute{Id: &id, Enabled: true}, nil, nil
+	case ChatOpsMSTeams:
+		return nil, nil, &MSTeamsRoute{Id: &id, Enabled: true}, nil
+	}
+	return nil, nil, nil, fmt.Errorf("unsupported chat-ops platform %q", ch.Platform)
+}
+
+// ApplyToCreateRoute enables posting to the channel in the route options.
+// Settings of other platforms are left untouched.
+func (ch *ChatOpsChannel) ApplyToCreateRoute(opt *CreateRouteOptions) error {
+	slack, telegram, msTeams, err := ch.routes()
+	if err != nil {
+		return err
+	}
+	switch {
+	case slack != nil:
+		opt.Slack = slack
+	case telegram != nil:
+		opt.Telegram = telegram
+	case msTeams != nil:
+		opt.MSTeams = msTeams
+	}
+	return nil
+}
+
+// ApplyToUpdateRoute enables posting to the channel in the route options.
+// Settings of other platforms are left untouched.
+func (ch *ChatOpsChannel) ApplyToUpdateRoute(opt *UpdateRouteOptions) error {
+	slack, telegram, msTeams, err := ch.routes()
+	if err != nil {
+		return err
+	}
+	switch {
+	case slack != nil:
+		opt.Slack = slack
+	case telegram != nil:
+		opt.Telegram = telegram
+	case msTeams != nil:
+		opt.MSTeams = msTeams
+	}
+	return nil
+}
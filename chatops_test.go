@@ -0,0 +1,78 @@
+package aapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestResolveChatOpsChannel(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/slack_channels/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testSlackChannelBody))
+	})
+	mux.HandleFunc("/api/v1/telegram_channels/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testTelegramChannelBody))
+	})
+	mux.HandleFunc("/api/v1/msteams_channels/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testMSTeamsChannelBody))
+	})
+
+	tests := []struct {
+		platform ChatOpsPlatform
+		name     string
+		want     *ChatOpsChannel
+	}{
+		{ChatOpsSlack, "general", &ChatOpsChannel{Platform: ChatOpsSlack, ID: "TEST_SLACK_ID", Name: "general"}},
+		{ChatOpsTelegram, "oncall-alerts", &ChatOpsChannel{Platform: ChatOpsTelegram, ID: "TBTH3E3GMAYB7", Name: "oncall-alerts"}},
+		{ChatOpsMSTeams, "Incidents", &ChatOpsChannel{Platform: ChatOpsMSTeams, ID: "MCJ8A6DA7X8WP", Name: "Incidents"}},
+	}
+	for _, tt := range tests {
+		channel, _, err := client.ResolveChatOpsChannel(tt.platform, tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tt.want, channel) {
+			t.Errorf("returned\n %+v\n want\n %+v\n", channel, tt.want)
+		}
+	}
+
+	if _, _, err := client.ResolveChatOpsChannel(ChatOpsTelegram, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, _, err := client.ResolveChatOpsChannel("discord", "general"); err == nil {
+		t.Error("expected error for unsupported platform")
+	}
+}
+
+func TestChatOpsChannelApplyToRoute(t *testing.T) {
+	slackID := "TEST_SLACK_ID"
+	opt := &UpdateRouteOptions{Slack: &SlackRoute{ChannelId: &slackID, Enabled: true}}
+
+	channel := &ChatOpsChannel{Platform: ChatOpsMSTeams, ID: "MCJ8A6DA7X8WP"}
+	if err := channel.ApplyToUpdateRoute(opt); err != nil {
+		t.Fatal(err)
+	}
+
+	msTeamsID := "MCJ8A6DA7X8WP"
+	want := &UpdateRouteOptions{
+		Slack:   &SlackRoute{ChannelId: &slackID, Enabled: true},
+		MSTeams: &MSTeamsRoute{Id: &msTeamsID, Enabled: true},
+	}
+	if !reflect.DeepEqual(want, opt) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", opt, want)
+	}
+
+	createOpt := &CreateRouteOptions{}
+	channel = &ChatOpsChannel{Platform: ChatOpsTelegram, ID: "TBTH3E3GMAYB7"}
+	if err := channel.ApplyToCreateRoute(createOpt); err != nil {
+		t.Fatal(err)
+	}
+	if createOpt.Telegram == nil || *createOpt.Telegram.Id != "TBTH3E3GMAYB7" || !createOpt.Telegram.Enabled {
+		t.Errorf("unexpected telegram route %+v", createOpt.Telegram)
+	}
+}
@@ -2,6 +2,7 @@ package aapi
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -9,6 +10,8 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-retryablehttp"
@@ -29,6 +32,74 @@ type PaginatedResponse struct {
 	Previous *string `json:"previous"`
 }
 
+var (
+	// ErrNotFound is returned by name-based lookups when nothing matches the given name.
+	ErrNotFound = errors.New("not found")
+	// ErrAmbiguous is returned by name-based lookups when more than one resource matches the given name.
+	ErrAmbiguous = errors.New("more than one match")
+)
+
+// forEachPage calls fetch for consecutive pages, starting from the first one,
+// until the API reports there is no next page. fetch is expected to read the
+// page number from opt.
+func forEachPage(opt *ListOptions, fetch func() (*PaginatedResponse, *http.Response, error)) (*http.Response, error) {
+	opt.Page = 1
+	for {
+		page, resp, err := fetch()
+		if err != nil {
+			return resp, err
+		}
+		if page == nil || page.Next == nil {
+			return resp, nil
+		}
+		opt.Page++
+	}
+}
+
+// findOneByName pages through list, starting from the first page, and returns
+// the only item whose name, as reported by nameOf, is exactly the given name.
+// It returns ErrNotFound or ErrAmbiguous unless exactly one item matches.
+func findOneByName[T any](resource, name string, list func(page int) ([]T, *PaginatedResponse, *http.Response, error), nameOf func(T) string) (T, *http.Response, error) {
+	var (
+		none    T
+		matches []T
+		opt     ListOptions
+	)
+	resp, err := forEachPage(&opt, func() (*PaginatedResponse, *http.Response, error) {
+		items, page, resp, err := list(opt.Page)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, item := range items {
+			if nameOf(item) == name {
+				matches = append(matches, item)
+			}
+		}
+		return page, resp, nil
+	})
+	if err != nil {
+		return none, resp, err
+	}
+
+	if err := checkExactlyOne(resource, name, len(matches)); err != nil {
+		return none, resp, err
+	}
+
+	return matches[0], resp, nil
+}
+
+// checkExactlyOne converts the number of resources matched by a name-based
+// lookup into ErrNotFound or ErrAmbiguous.
+func checkExactlyOne(resource, name string, matches int) error {
+	switch {
+	case matches == 0:
+		return fmt.Errorf("%s %q: %w", resource, name, ErrNotFound)
+	case matches > 1:
+		return fmt.Errorf("%s %q: %w (%d found)", resource, name, ErrAmbiguous, matches)
+	}
+	return nil
+}
+
 type Client struct {
 	// HTTP client used to communicate with the API.
 	client     *retryablehttp.Client
@@ -36,6 +107,19 @@ type Client struct {
 	baseURL    *url.URL
 	grafanaURL *url.URL
 	UserAgent  string
+
+	// Client-side throttling, rate limit info of the last response, logging,
+	// middlewares, response cache and dry-run mode, guarded by mu.
+	mu            sync.Mutex
+	limiter       RateLimiter
+	rateLimit     RateLimit
+	logger        Logger
+	logBodies     bool
+	middlewares   []Middleware
+	cache         CacheStore
+	dryRun        bool
+	dryRunJournal []DryRunEntry
+
 	// List of Services. Keep in sync with func newClient
 	Alerts                *AlertService
 	AlertGroups           *AlertGroupService
@@ -51,6 +135,13 @@ type Client struct {
 	Teams                 *TeamService
 	Webhooks              *WebhookService
 	UserNotificationRules *UserNotificationRuleService
+	ResolutionNotes       *ResolutionNoteService
+	ShiftSwaps            *ShiftSwapService
+	DirectPaging          *DirectPagingService
+	Organizations         *OrganizationService
+	TelegramChannels      *TelegramChannelService
+	MSTeamsChannels       *MSTeamsChannelService
+	Labels                *LabelService
 }
 
 func NewWithGrafanaURL(base_url, token, grafana_url string) (*Client, error) {
@@ -82,6 +173,11 @@ func newClient(url, grafana_url string) (*Client, error) {
 
 	// retryablehttp.Client will retry up to 4 times on recoverable errors (429, 5xx, and low-level network errors)
 	c.client = retryablehttp.NewClient()
+	// Drop the default logger writing to stderr, nothing is logged until SetLogger is called.
+	c.client.Logger = nil
+	// Honour Retry-After and X-RateLimit-Reset when retrying throttled requests.
+	c.client.Backoff = rateLimitBackoff
+	c.client.HTTPClient.Transport = &transport{client: c, base: c.client.HTTPClient.Transport}
 
 	// Set the default base URL. _ suppress error handling
 	err := c.setBaseURL(url)
@@ -111,6 +207,13 @@ func newClient(url, grafana_url string) (*Client, error) {
 	c.Teams = NewTeamService(c)
 	c.Webhooks = NewWebhookService(c)
 	c.UserNotificationRules = NewUserNotificationRuleService(c)
+	c.ResolutionNotes = NewResolutionNoteService(c)
+	c.ShiftSwaps = NewShiftSwapService(c)
+	c.DirectPaging = NewDirectPagingService(c)
+	c.Organizations = NewOrganizationService(c)
+	c.TelegramChannels = NewTelegramChannelService(c)
+	c.MSTeamsChannels = NewMSTeamsChannelService(c)
+	c.Labels = NewLabelService(c)
 
 	return c, nil
 }
@@ -146,7 +249,19 @@ func (c *Client) setGrafanaURL(urlStr string) error {
 	return nil
 }
 
-func (c *Client) NewRequest(method, path string, opt interface{}) (*retryablehttp.Request, error) {
+// SetTransport replaces the http.RoundTripper used to send requests, e.g. with a Cassette.
+// Client-side rate limiting still applies. It should be called before the client is used.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	if t, ok := c.client.HTTPClient.Transport.(*transport); ok {
+		t.base = rt
+		return
+	}
+	c.client.HTTPClient.Transport = &transport{client: c, base: rt}
+}
+
+// NewRequest creates an API request. A relative path should be provided, opt is
+// sent as JSON body for POST and PUT requests and as query string otherwise.
+func (c *Client) NewRequest(method, path string, opt interface{}, options ...RequestOption) (*retryablehttp.Request, error) {
 	u := *c.baseURL
 	unescaped, err := url.PathUnescape(path)
 
@@ -185,12 +300,24 @@ func (c *Client) NewRequest(method, path string, opt interface{}) (*retryablehtt
 	}
 
 	req, err := retryablehttp.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set the request specific headers.
 	for k, v := range reqHeaders {
 		req.Header[k] = v
 	}
 
+	for _, fn := range options {
+		if fn == nil {
+			continue
+		}
+		if err := fn(req); err != nil {
+			return nil, err
+		}
+	}
+
 	return req, nil
 }
 
@@ -198,10 +325,20 @@ func (c *Client) NewRequest(method, path string, opt interface{}) (*retryablehtt
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*http.Response, error) {
-	resp, err := c.client.Do(req)
+	req = withRequestStats(req)
+
+	start := time.Now()
+	resp, err := c.doer().Do(req)
+	if logger, logBodies := c.loggerSettings(); logger != nil {
+		logRequest(logger, logBodies, req, resp, err, time.Since(start))
+	}
 	if err != nil {
 		return nil, err
 	}
+	if resp.Body == nil {
+		// Middlewares may answer requests with responses without a body.
+		resp.Body = http.NoBody
+	}
 	defer resp.Body.Close()
 
 	err = CheckResponse(resp)
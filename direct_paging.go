@@ -0,0 +1,78 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DirectPagingService handles requests to escalation endpoint, paging teams and users directly,
+// without an integration
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/escalation/
+type DirectPagingService struct {
+	client *Client
+	url    string
+}
+
+// NewDirectPagingService creates DirectPagingService with corresponding url part
+func NewDirectPagingService(client *Client) *DirectPagingService {
+	directPagingService := DirectPagingService{}
+	directPagingService.client = client
+	directPagingService.url = "escalation"
+	return &directPagingService
+}
+
+// UserImportance is a user to page. Important users are notified with their important notification rules.
+type UserImportance struct {
+	ID        string `json:"id"`
+	Important bool   `json:"important"`
+}
+
+type DirectPagingOptions struct {
+	Title     string           `json:"title,omitempty"`
+	Message   string           `json:"message,omitempty"`
+	SourceUrl string           `json:"source_url,omitempty"`
+	Team      string           `json:"team,omitempty"`
+	Users     []UserImportance `json:"users,omitempty"`
+	// AlertGroupID pages more users into an existing alert group. Title, Message,
+	// SourceUrl and Team can't be set together with it.
+	AlertGroupID string `json:"alert_group_id,omitempty"`
+}
+
+// Validate checks if the options are valid
+func (o *DirectPagingOptions) Validate() error {
+	if o.Team == "" && len(o.Users) == 0 {
+		return fmt.Errorf("either team or users must be set")
+	}
+	if o.AlertGroupID != "" && (o.Title != "" || o.Message != "" || o.SourceUrl != "" || o.Team != "") {
+		return fmt.Errorf("title, message, source_url and team can't be set together with alert_group_id")
+	}
+	return nil
+}
+
+// Page creates an alert group escalated to given team and users, or pages users into an existing alert group.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/escalation/#escalate
+func (service *DirectPagingService) Page(opt *DirectPagingOptions) (*AlertGroup, *http.Response, error) {
+	if opt == nil {
+		return nil, nil, fmt.Errorf("direct paging options are required")
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("DirectPaging.Page", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alertGroup := new(AlertGroup)
+	resp, err := service.client.Do(req, alertGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alertGroup, resp, err
+}
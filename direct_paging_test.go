@@ -0,0 +1,73 @@
+package aapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDirectPage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/escalation/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		body, _ := io.ReadAll(r.Body)
+		want := `{"title":"Database down","message":"Primary is not responding","team":"T3HRAP3K3IKOP","users":[{"id":"U4DNY931HHJS5","important":true}]}`
+		if got := string(body); got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": "IZHCC4GTNPZ93",
+			"integration_id": "CC3GZYZNIIEH5",
+			"route_id": "RDN8LITALJXCJ",
+			"alerts_count": 1,
+			"state": "firing",
+			"created_at": "2024-08-15T18:05:36.801215Z",
+			"title": "Database down",
+			"permalinks": {"web": "http://localhost:3000/a/grafana-oncall-app/alert-groups/IZHCC4GTNPZ93"}
+		}`)
+	})
+
+	alertGroup, _, err := client.DirectPaging.Page(&DirectPagingOptions{
+		Title:   "Database down",
+		Message: "Primary is not responding",
+		Team:    "T3HRAP3K3IKOP",
+		Users:   []UserImportance{{ID: "U4DNY931HHJS5", Important: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &AlertGroup{
+		ID:            "IZHCC4GTNPZ93",
+		IntegrationID: "CC3GZYZNIIEH5",
+		RouteID:       "RDN8LITALJXCJ",
+		AlertsCount:   1,
+		State:         "firing",
+		CreatedAt:     "2024-08-15T18:05:36.801215Z",
+		Title:         "Database down",
+		Permalinks:    map[string]string{"web": "http://localhost:3000/a/grafana-oncall-app/alert-groups/IZHCC4GTNPZ93"},
+	}
+	if !reflect.DeepEqual(want, alertGroup) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", alertGroup, want)
+	}
+}
+
+func TestDirectPageValidation(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	options := []*DirectPagingOptions{
+		{Title: "Database down"},
+		{AlertGroupID: "IZHCC4GTNPZ93", Team: "T3HRAP3K3IKOP"},
+	}
+	for _, opt := range options {
+		if _, _, err := client.DirectPaging.Page(opt); err == nil {
+			t.Errorf("expected error for %+v", opt)
+		}
+	}
+}
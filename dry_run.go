@@ -0,0 +1,113 @@
+package aapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// dryRunHeader is set on synthetic responses returned in dry-run mode.
+const dryRunHeader = "X-Dry-Run"
+
+// DryRunEntry is a mutating request which was recorded instead of being sent.
+// Credentials in Body are redacted.
+type DryRunEntry struct {
+	Method string
+	// Path is relative to the API base URL, e.g. "integrations/CFRPV98RPR1U8/".
+	Path string
+	Body json.RawMessage
+}
+
+func (e DryRunEntry) String() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("%s %s", e.Method, e.Path)
+	}
+	return fmt.Sprintf("%s %s %s", e.Method, e.Path, e.Body)
+}
+
+// SetDryRun enables or disables dry-run mode. In dry-run mode POST, PUT and DELETE
+// requests are not sent: they are recorded to the journal returned by DryRunJournal,
+// and a synthetic successful response echoing the request body is returned instead.
+// GET requests still hit the API.
+func (c *Client) SetDryRun(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dryRun = enabled
+}
+
+// DryRunJournal returns requests recorded in dry-run mode, in the order they were made.
+func (c *Client) DryRunJournal() []DryRunEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	journal := make([]DryRunEntry, len(c.dryRunJournal))
+	copy(journal, c.dryRunJournal)
+	return journal
+}
+
+// ResetDryRunJournal clears requests recorded in dry-run mode.
+func (c *Client) ResetDryRunJournal() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dryRunJournal = nil
+}
+
+// dryRunDoer wraps next, recording mutating requests instead of passing them on.
+func (c *Client) dryRunDoer(next Doer) Doer {
+	return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet || req.Method == http.MethodHead {
+			return next.Do(req)
+		}
+
+		body, err := req.BodyBytes()
+		if err != nil {
+			return nil, err
+		}
+
+		entry := DryRunEntry{
+			Method: req.Method,
+			Path:   strings.TrimPrefix(req.URL.Path, c.baseURL.Path),
+		}
+		if len(body) > 0 {
+			entry.Body = json.RawMessage(redactBody(body))
+		}
+
+		c.mu.Lock()
+		c.dryRunJournal = append(c.dryRunJournal, entry)
+		c.mu.Unlock()
+
+		resp := &http.Response{
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     make(http.Header),
+			Request:    req.Request,
+		}
+		resp.Header.Set(dryRunHeader, "1")
+
+		switch req.Method {
+		case http.MethodDelete:
+			resp.StatusCode = http.StatusNoContent
+			resp.Body = http.NoBody
+		case http.MethodPost:
+			resp.StatusCode = http.StatusCreated
+		default:
+			resp.StatusCode = http.StatusOK
+		}
+		if resp.Body == nil {
+			if len(body) == 0 {
+				body = []byte("{}")
+			}
+			resp.Header.Set("Content-Type", "application/json")
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			resp.ContentLength = int64(len(body))
+		}
+		resp.Status = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+
+		return resp, nil
+	})
+}
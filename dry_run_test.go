@@ -0,0 +1,56 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/escalation_chains/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+	mux.HandleFunc("/api/v1/escalation_chains/FWDL7M6N6I9HE/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s request should not be sent in dry-run mode", r.Method)
+	})
+
+	client.SetDryRun(true)
+
+	if _, _, err := client.EscalationChains.ListEscalationChains(&ListEscalationChainOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	chain, _, err := client.EscalationChains.UpdateEscalationChain("FWDL7M6N6I9HE", &UpdateEscalationChainOptions{Name: "renamed", TeamId: "T3HRAP3K3IKOP"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chain.Name != "renamed" {
+		t.Errorf("Expected synthetic response to echo request body, got %+v", chain)
+	}
+
+	if _, err := client.EscalationChains.DeleteEscalationChain("FWDL7M6N6I9HE", &DeleteEscalationChainOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	journal := client.DryRunJournal()
+	if len(journal) != 2 {
+		t.Fatalf("Expected 2 journal entries, got %d", len(journal))
+	}
+
+	want := `PUT escalation_chains/FWDL7M6N6I9HE/ {"name":"renamed","team_id":"T3HRAP3K3IKOP"}`
+	if journal[0].String() != want {
+		t.Errorf("Journal entry is %s, want %s", journal[0], want)
+	}
+	if want := "DELETE escalation_chains/FWDL7M6N6I9HE/"; journal[1].String() != want {
+		t.Errorf("Journal entry is %s, want %s", journal[1], want)
+	}
+
+	client.ResetDryRunJournal()
+	if len(client.DryRunJournal()) != 0 {
+		t.Error("Expected journal to be empty after reset")
+	}
+}
@@ -43,7 +43,7 @@ type ListEscalationChainOptions struct {
 func (service *EscalationChainService) ListEscalationChains(opt *ListEscalationChainOptions) (*PaginatedEscalationChainsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("EscalationChains.ListEscalationChains", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,6 +57,20 @@ func (service *EscalationChainService) ListEscalationChains(opt *ListEscalationC
 	return escalation_chains, resp, err
 }
 
+// GetByName fetches the escalation chain with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one escalation chain matches.
+func (service *EscalationChainService) GetByName(name string) (*EscalationChain, *http.Response, error) {
+	opt := &ListEscalationChainOptions{Name: name}
+	return findOneByName("escalation chain", name, func(page int) ([]*EscalationChain, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListEscalationChains(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.EscalationChains, &result.PaginatedResponse, resp, nil
+	}, func(escalationChain *EscalationChain) string { return escalationChain.Name })
+}
+
 type GetEscalationChainOptions struct {
 }
 
@@ -66,7 +80,7 @@ type GetEscalationChainOptions struct {
 func (service *EscalationChainService) GetEscalationChain(id string, opt *GetEscalationChainOptions) (*EscalationChain, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("EscalationChains.GetEscalationChain", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -90,7 +104,7 @@ type CreateEscalationChainOptions struct {
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/escalation_chains/#create-an-escalation-chain
 func (service *EscalationChainService) CreateEscalationChain(opt *CreateEscalationChainOptions) (*EscalationChain, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("EscalationChains.CreateEscalationChain", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,7 +131,7 @@ type UpdateEscalationChainOptions struct {
 func (service *EscalationChainService) UpdateEscalationChain(id string, opt *UpdateEscalationChainOptions) (*EscalationChain, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("EscalationChains.UpdateEscalationChain", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -141,7 +155,7 @@ func (service *EscalationChainService) DeleteEscalationChain(id string, opt *Del
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("EscalationChains.DeleteEscalationChain", id))
 	if err != nil {
 		return nil, err
 	}
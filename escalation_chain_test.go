@@ -0,0 +1,64 @@
+package aapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testEscalationChain = &EscalationChain{
+	ID:     "FWDL7M6N6I9HE",
+	Name:   "default",
+	TeamId: "T3HRAP3K3IKOP",
+}
+
+var testEscalationChainBody = `{
+	"id": "FWDL7M6N6I9HE",
+	"name": "default",
+	"team_id": "T3HRAP3K3IKOP"
+}`
+
+func TestGetEscalationChainByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/escalation_chains/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("name"); got != "default" {
+			t.Errorf("name filter is %q, want default", got)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"count": 2, "next": "next", "previous": null, "results": [{"id": "FANOTHER", "name": "default 2"}]}`)
+		case "2":
+			fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": null, "previous": "previous", "results": [%s]}`, testEscalationChainBody))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	escalationChain, _, err := client.EscalationChains.GetByName("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testEscalationChain, escalationChain) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", escalationChain, testEscalationChain)
+	}
+}
+
+func TestGetEscalationChainByNameNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/escalation_chains/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+
+	_, _, err := client.EscalationChains.GetByName("default")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
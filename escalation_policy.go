@@ -2,7 +2,6 @@ package aapi
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 )
 
@@ -56,7 +55,7 @@ type ListEscalationOptions struct {
 func (service *EscalationService) ListEscalations(opt *ListEscalationOptions) (*PaginatedEscalationsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Escalations.ListEscalations", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,7 +78,7 @@ type GetEscalationOptions struct {
 func (service *EscalationService) GetEscalation(id string, opt *GetEscalationOptions) (*Escalation, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Escalations.GetEscalation", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -116,7 +115,7 @@ type CreateEscalationOptions struct {
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/escalation_policies/#create-an-escalation-policy
 func (service *EscalationService) CreateEscalation(opt *CreateEscalationOptions) (*Escalation, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Escalations.CreateEscalation", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,7 +123,6 @@ func (service *EscalationService) CreateEscalation(opt *CreateEscalationOptions)
 	escalation := new(Escalation)
 
 	resp, err := service.client.Do(req, escalation)
-	log.Printf("[DEBUG] request success")
 
 	if err != nil {
 		return nil, resp, err
@@ -154,7 +152,7 @@ type UpdateEscalationOptions struct {
 func (service *EscalationService) UpdateEscalation(id string, opt *UpdateEscalationOptions) (*Escalation, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("Escalations.UpdateEscalation", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -178,7 +176,7 @@ func (service *EscalationService) DeleteEscalation(id string, opt *DeleteEscalat
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("Escalations.DeleteEscalation", id))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,162 @@
+package aapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Heartbeat of an integration. OnCall creates an alert group when the heartbeat
+// Link is not called for longer than TimeoutSeconds.
+type Heartbeat struct {
+	Link              string  `json:"link"`
+	TimeoutSeconds    *int    `json:"timeout_seconds,omitempty"`
+	LastHeartbeatTime *string `json:"last_heartbeat_time,omitempty"`
+	Alive             *bool   `json:"is_alive,omitempty"`
+}
+
+type UpdateHeartbeatOptions struct {
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// updateHeartbeatBody only carries the heartbeat, so that the update leaves
+// other integration fields, such as team_id, untouched.
+type updateHeartbeatBody struct {
+	Heartbeat *UpdateHeartbeatOptions `json:"heartbeat"`
+}
+
+// UpdateHeartbeat sets the heartbeat timeout of the integration.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/integrations/#update-integration
+func (service *IntegrationService) UpdateHeartbeat(id string, opt *UpdateHeartbeatOptions) (*Integration, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("PUT", u, &updateHeartbeatBody{Heartbeat: opt}, WithOperation("Integrations.UpdateHeartbeat", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	integration := new(Integration)
+	resp, err := service.client.Do(req, integration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return integration, resp, err
+}
+
+// HeartbeatSender periodically calls an integration heartbeat link,
+// e.g. from a service which should raise an alert when it stops running.
+//
+//	sender := aapi.NewHeartbeatSender(integration.Heartbeat.Link, time.Minute, 10*time.Second)
+//	sender.Start()
+//	defer sender.Stop()
+type HeartbeatSender struct {
+	link     string
+	interval time.Duration
+	jitter   time.Duration
+
+	// HTTPClient sends heartbeats. It defaults to a client with a 10 seconds timeout.
+	HTTPClient *http.Client
+	// OnError, when set, is called with every failed heartbeat. It runs on the
+	// sending goroutine, so it must not call Stop, which would wait for it forever.
+	OnError func(err error)
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// defaultHeartbeatInterval is used when NewHeartbeatSender is given no interval.
+const defaultHeartbeatInterval = time.Minute
+
+// NewHeartbeatSender creates HeartbeatSender calling link every interval
+// plus a random delay of up to jitter. A non-positive interval is replaced
+// with one minute, so that the sender does not flood the link.
+func NewHeartbeatSender(link string, interval, jitter time.Duration) *HeartbeatSender {
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+	return &HeartbeatSender{
+		link:       link,
+		interval:   interval,
+		jitter:     jitter,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Start sends a heartbeat right away and keeps sending them in background until Stop.
+// It does nothing when the sender is already running.
+func (s *HeartbeatSender) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return
+	}
+
+	s.stop, s.done = make(chan struct{}), make(chan struct{})
+	go s.run(s.stop, s.done)
+}
+
+// Stop stops sending heartbeats. An in-flight heartbeat is not interrupted,
+// Stop waits for it to finish, so no heartbeat is sent after Stop returns.
+// The sender can be started again afterwards.
+func (s *HeartbeatSender) Stop() {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (s *HeartbeatSender) run(stop, done chan struct{}) {
+	defer close(done)
+
+	for {
+		// Heartbeats are bounded by the HTTPClient timeout rather than cancelled by Stop.
+		if err := s.Send(context.Background()); err != nil && s.OnError != nil {
+			s.OnError(err)
+		}
+
+		delay := s.interval
+		if s.jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(s.jitter)))
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// Send sends a single heartbeat.
+func (s *HeartbeatSender) Send(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.link, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("heartbeat %s returned %s", s.link, resp.Status)
+	}
+	return nil
+}
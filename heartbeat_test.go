@@ -0,0 +1,155 @@
+package aapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUpdateHeartbeat(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/integrations/CFRPV98RPR1U8/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "PUT")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"heartbeat":{"timeout_seconds":600}}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{
+			"id": "CFRPV98RPR1U8",
+			"team_id": "T3HRAP3K3IKOP",
+			"name": "Test Grafana",
+			"heartbeat": {
+				"link": "https://grafana_url/integrations/v1/grafana/mReAoNwDm0eMwKo1mTeTwYo/heartbeat/",
+				"timeout_seconds": 600,
+				"last_heartbeat_time": "2023-08-18T16:38:23.106007Z",
+				"is_alive": true
+			}
+		}`)
+	})
+
+	integration, _, err := client.Integrations.UpdateHeartbeat("CFRPV98RPR1U8", &UpdateHeartbeatOptions{TimeoutSeconds: 600})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeout := 600
+	lastHeartbeatTime := "2023-08-18T16:38:23.106007Z"
+	alive := true
+	want := &Heartbeat{
+		Link:              "https://grafana_url/integrations/v1/grafana/mReAoNwDm0eMwKo1mTeTwYo/heartbeat/",
+		TimeoutSeconds:    &timeout,
+		LastHeartbeatTime: &lastHeartbeatTime,
+		Alive:             &alive,
+	}
+	if !reflect.DeepEqual(want, integration.Heartbeat) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", integration.Heartbeat, want)
+	}
+}
+
+func TestHeartbeatLinkOnly(t *testing.T) {
+	var integration Integration
+	body := `{"id": "CFRPV98RPR1U8", "heartbeat": {"link": "https://grafana_url/heartbeat/"}}`
+	if err := json.Unmarshal([]byte(body), &integration); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Heartbeat{Link: "https://grafana_url/heartbeat/"}
+	if !reflect.DeepEqual(want, integration.Heartbeat) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", integration.Heartbeat, want)
+	}
+}
+
+func TestHeartbeatSender(t *testing.T) {
+	var stopped int32
+	calls := make(chan struct{}, 100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&stopped) == 1 {
+			t.Error("heartbeat sent after Stop returned")
+		}
+		calls <- struct{}{}
+	}))
+	defer server.Close()
+
+	sender := NewHeartbeatSender(server.URL, time.Millisecond, time.Millisecond)
+	sender.OnError = func(err error) {
+		t.Error(err)
+	}
+	sender.Start()
+	sender.Start()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-calls:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("got %d heartbeats, want at least 2", i)
+		}
+	}
+	sender.Stop()
+	atomic.StoreInt32(&stopped, 1)
+
+	sender.Stop()
+}
+
+func TestHeartbeatSenderDefaultInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		sender := NewHeartbeatSender("https://grafana_url/heartbeat/", interval, 0)
+		if sender.interval != time.Minute {
+			t.Errorf("interval %v: got %v, want %v", interval, sender.interval, time.Minute)
+		}
+	}
+}
+
+func TestHeartbeatSenderStopWaitsForInFlight(t *testing.T) {
+	arrived, release := make(chan struct{}), make(chan struct{})
+	var completed int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(arrived)
+		<-release
+		atomic.StoreInt32(&completed, 1)
+	}))
+	defer server.Close()
+
+	sender := NewHeartbeatSender(server.URL, time.Hour, 0)
+	sender.OnError = func(err error) {
+		t.Errorf("in-flight heartbeat failed: %v", err)
+	}
+	sender.Start()
+	<-arrived
+
+	stopped := make(chan struct{})
+	go func() {
+		sender.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Error("Stop returned while a heartbeat was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-stopped
+
+	if atomic.LoadInt32(&completed) != 1 {
+		t.Error("Stop returned before the in-flight heartbeat finished")
+	}
+}
+
+func TestHeartbeatSenderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sender := NewHeartbeatSender(server.URL, time.Minute, 0)
+	if err := sender.Send(context.Background()); err == nil {
+		t.Error("expected error for 503 response")
+	}
+}
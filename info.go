@@ -0,0 +1,72 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Info describes the OnCall stack and organization the client talks to.
+type Info struct {
+	OrganizationID   string
+	OrganizationName string
+	// StackURL is the URL of the Grafana stack OnCall is connected to.
+	StackURL string
+	// APIVersion is the version of the OnCall backend.
+	APIVersion string
+	// Features are feature flags enabled for the organization. OnCall versions
+	// which don't report them leave it empty.
+	Features []string
+}
+
+type infoResponse struct {
+	Url      string   `json:"url"`
+	Version  string   `json:"version"`
+	Features []string `json:"features"`
+}
+
+func (c *Client) getInfo() (*infoResponse, *http.Response, error) {
+	req, err := c.NewRequest("GET", "info/", nil, WithOperation("Info", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := new(infoResponse)
+	resp, err := c.Do(req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return info, resp, err
+}
+
+// Info fetches the stack and organization the client token belongs to.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/info/
+func (c *Client) Info() (*Info, *http.Response, error) {
+	info, resp, err := c.getInfo()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	organization, resp, err := c.Organizations.GetCurrentOrganization()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &Info{
+		OrganizationID:   organization.ID,
+		OrganizationName: organization.Name,
+		StackURL:         info.Url,
+		APIVersion:       info.Version,
+		Features:         info.Features,
+	}, resp, nil
+}
+
+// Ping checks that the API is reachable and the client token is valid.
+func (c *Client) Ping() (*http.Response, error) {
+	_, resp, err := c.getInfo()
+	if err != nil {
+		return resp, fmt.Errorf("ping failed: %w", err)
+	}
+	return resp, nil
+}
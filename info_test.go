@@ -0,0 +1,64 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInfo(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/info/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, `{"url": "https://example.grafana.net", "version": "v1.9.0", "features": ["msteams", "labels"]}`)
+	})
+	mux.HandleFunc("/api/v1/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testOrganizationBody))
+	})
+
+	info, _, err := client.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Info{
+		OrganizationID:   "O53AAGWFBPE5W",
+		OrganizationName: "Test Org",
+		StackURL:         "https://example.grafana.net",
+		APIVersion:       "v1.9.0",
+		Features:         []string{"msteams", "labels"},
+	}
+	if !reflect.DeepEqual(want, info) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", info, want)
+	}
+}
+
+func TestPing(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/info/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"detail": "Invalid token."}`)
+			return
+		}
+		fmt.Fprint(w, `{"url": "https://example.grafana.net", "version": "v1.9.0"}`)
+	})
+
+	if _, err := client.Ping(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	client.token = "wrong"
+	resp, err := client.Ping()
+	if err == nil {
+		t.Fatal("expected error for invalid token")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401 response, got %v", resp)
+	}
+}
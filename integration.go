@@ -37,6 +37,11 @@ type Integration struct {
 	Templates      *Templates    `json:"templates"`
 	Labels         []*Label      `json:"labels"`
 	DynamicLabels  []*Label      `json:"dynamic_labels"`
+	Heartbeat      *Heartbeat    `json:"heartbeat"`
+	// Maintenance fields are nil when the integration is not in maintenance.
+	MaintenanceMode      *string `json:"maintenance_mode"`
+	MaintenanceStartedAt *string `json:"maintenance_started_at"`
+	MaintenanceEndAt     *string `json:"maintenance_end_at"`
 }
 
 type DefaultRoute struct {
@@ -91,11 +96,13 @@ type Label struct {
 }
 
 type KeyValueName struct {
+	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
 }
 
 type ListIntegrationOptions struct {
 	ListOptions
+	Name string `url:"name,omitempty" json:"name,omitempty"`
 }
 
 // ListIntegrations fetches all integrations for current organization.
@@ -104,7 +111,7 @@ type ListIntegrationOptions struct {
 func (service *IntegrationService) ListIntegrations(opt *ListIntegrationOptions) (*PaginatedIntegrationsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Integrations.ListIntegrations", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -118,6 +125,20 @@ func (service *IntegrationService) ListIntegrations(opt *ListIntegrationOptions)
 	return integrations, resp, err
 }
 
+// GetByName fetches the integration with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one integration matches.
+func (service *IntegrationService) GetByName(name string) (*Integration, *http.Response, error) {
+	opt := &ListIntegrationOptions{Name: name}
+	return findOneByName("integration", name, func(page int) ([]*Integration, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListIntegrations(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.Integrations, &result.PaginatedResponse, resp, nil
+	}, func(integration *Integration) string { return integration.Name })
+}
+
 type GetIntegrationOptions struct {
 }
 
@@ -127,7 +148,7 @@ type GetIntegrationOptions struct {
 func (service *IntegrationService) GetIntegration(id string, opt *GetIntegrationOptions) (*Integration, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Integrations.GetIntegration", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,22 +163,46 @@ func (service *IntegrationService) GetIntegration(id string, opt *GetIntegration
 }
 
 type CreateIntegrationOptions struct {
-	TeamId        string        `json:"team_id"`
-	Name          string        `json:"name,omitempty"`
-	Type          string        `json:"type,omitempty"`
-	Templates     *Templates    `json:"templates,omitempty"`
-	DefaultRoute  *DefaultRoute `json:"default_route,omitempty"`
-	Labels        []*Label      `json:"labels,omitempty"`
-	DynamicLabels []*Label      `json:"dynamic_labels,omitempty"`
+	TeamId       string        `json:"team_id"`
+	Name         string        `json:"name,omitempty"`
+	Type         string        `json:"type,omitempty"`
+	Templates    *Templates    `json:"templates,omitempty"`
+	DefaultRoute *DefaultRoute `json:"default_route,omitempty"`
+	// Labels must exist in the label schema. CreateIntegration does not check it,
+	// use CreateIntegrationWithLabelCheck to do so before creating.
+	Labels []*Label `json:"labels,omitempty"`
+	// DynamicLabels have values templated from the alert payload, only their keys must exist.
+	DynamicLabels []*Label `json:"dynamic_labels,omitempty"`
+}
+
+// Validate checks if the options are valid
+func (o *CreateIntegrationOptions) Validate() error {
+	for _, label := range o.Labels {
+		if err := label.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, label := range o.DynamicLabels {
+		if err := label.Validate(); err != nil {
+			return fmt.Errorf("invalid dynamic label: %w", err)
+		}
+	}
+	return nil
 }
 
 // CreateIntegration creates integration with type, team_id and optional given name.
 //
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/integrations/#get-integration
 func (service *IntegrationService) CreateIntegration(opt *CreateIntegrationOptions) (*Integration, *http.Response, error) {
+	if opt != nil {
+		if err := opt.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Integrations.CreateIntegration", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -171,6 +216,25 @@ func (service *IntegrationService) CreateIntegration(opt *CreateIntegrationOptio
 	return integration, resp, err
 }
 
+// CreateIntegrationWithLabelCheck creates integration like CreateIntegration, after checking
+// that labels and keys of dynamic labels exist, see LabelService.ResolveLabels.
+// Label IDs and names are filled in on opt.
+func (service *IntegrationService) CreateIntegrationWithLabelCheck(opt *CreateIntegrationOptions) (*Integration, *http.Response, error) {
+	if opt != nil {
+		if err := opt.Validate(); err != nil {
+			return nil, nil, err
+		}
+		if resp, err := service.client.Labels.ResolveLabels(opt.Labels); err != nil {
+			return nil, resp, err
+		}
+		if resp, err := service.client.Labels.ResolveLabelKeys(opt.DynamicLabels); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return service.CreateIntegration(opt)
+}
+
 type UpdateIntegrationOptions struct {
 	Name          string        `json:"name,omitempty"`
 	TeamId        string        `json:"team_id"`
@@ -187,7 +251,7 @@ type UpdateIntegrationOptions struct {
 func (service *IntegrationService) UpdateIntegration(id string, opt *UpdateIntegrationOptions) (*Integration, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("Integrations.UpdateIntegration", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -211,7 +275,7 @@ func (service *IntegrationService) DeleteIntegration(id string, opt *DeleteInteg
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("Integrations.DeleteIntegration", id))
 	if err != nil {
 		return nil, err
 	}
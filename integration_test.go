@@ -241,3 +241,25 @@ func TestGetIntegration(t *testing.T) {
 		t.Errorf("returned\n %+v\n want\n %+v\n", integration, want)
 	}
 }
+
+func TestGetIntegrationByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/integrations/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("name"); got != "Test Grafana" {
+			t.Errorf("name filter is %q, want %q", got, "Test Grafana")
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": null, "previous": null, "results": [{"id": "CANOTHER", "name": "Test Grafana 2"}, %s]}`, testIntegrationBody))
+	})
+
+	integration, _, err := client.Integrations.GetByName("Test Grafana")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if integration.ID != testIntegration.ID {
+		t.Errorf("returned integration %s, want %s", integration.ID, testIntegration.ID)
+	}
+}
@@ -0,0 +1,241 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// LabelService handles requests to label endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/labels/
+type LabelService struct {
+	client *Client
+	url    string
+}
+
+// NewLabelService creates LabelService with corresponding url part
+func NewLabelService(client *Client) *LabelService {
+	labelService := LabelService{}
+	labelService.client = client
+	labelService.url = "labels"
+	return &labelService
+}
+
+// LabelSchema is a label key together with all its values.
+type LabelSchema struct {
+	Key    KeyValueName    `json:"key"`
+	Values []*KeyValueName `json:"values"`
+}
+
+// NewLabel creates a label with given key and value names.
+func NewLabel(key, value string) *Label {
+	return &Label{Key: KeyValueName{Name: key}, Value: KeyValueName{Name: value}}
+}
+
+// ParseLabelFilter parses a "key:value" filter string into a label.
+func ParseLabelFilter(filter string) (*Label, error) {
+	key, value, ok := strings.Cut(filter, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid label filter %q. Expected format: key:value", filter)
+	}
+	label := NewLabel(key, value)
+	if err := label.Validate(); err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// Validate checks that the label has both key and value, each given by ID or name,
+// and that the key name can be used in a filter string.
+func (l *Label) Validate() error {
+	if (l.Key.ID == "" && l.Key.Name == "") || (l.Value.ID == "" && l.Value.Name == "") {
+		return fmt.Errorf("invalid label %s: key and value IDs or names are required", l.describe())
+	}
+	if strings.Contains(l.Key.Name, ":") {
+		return fmt.Errorf("invalid label %s: key name can't contain ':'", l.describe())
+	}
+	return nil
+}
+
+// describe names the label in errors, by IDs when it has no names.
+func (l *Label) describe() string {
+	if l.Key.Name != "" && l.Value.Name != "" {
+		return fmt.Sprintf("%q", l.Filter())
+	}
+	return fmt.Sprintf("(key %s, value %s)", describeKeyValueName(l.Key), describeKeyValueName(l.Value))
+}
+
+// Filter returns the "key:value" string alert group filters expect, see ListAlertGroupOptions.Labels.
+func (l *Label) Filter() string {
+	return l.Key.Name + ":" + l.Value.Name
+}
+
+// LabelFilters returns filter strings of labels.
+func LabelFilters(labels ...*Label) []string {
+	filters := make([]string, 0, len(labels))
+	for _, label := range labels {
+		filters = append(filters, label.Filter())
+	}
+	return filters
+}
+
+// ListLabelKeys fetches all label keys for current organization.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/labels/#list-label-keys
+func (service *LabelService) ListLabelKeys() ([]*KeyValueName, *http.Response, error) {
+	u := fmt.Sprintf("%s/keys/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, nil, WithOperation("Labels.ListLabelKeys", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*KeyValueName
+	resp, err := service.client.Do(req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, err
+}
+
+// GetLabelKey fetches label key by given id, together with its values.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/labels/#get-label-key
+func (service *LabelService) GetLabelKey(id string) (*LabelSchema, *http.Response, error) {
+	u := fmt.Sprintf("%s/id/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("GET", u, nil, WithOperation("Labels.GetLabelKey", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := new(LabelSchema)
+	resp, err := service.client.Do(req, schema)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schema, resp, err
+}
+
+type CreateLabelKeyOptions struct {
+	Key    KeyValueName   `json:"key"`
+	Values []KeyValueName `json:"values"`
+}
+
+// CreateLabelKey creates label key with given values.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/labels/#create-label-key
+func (service *LabelService) CreateLabelKey(opt *CreateLabelKeyOptions) (*LabelSchema, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Labels.CreateLabelKey", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := new(LabelSchema)
+	resp, err := service.client.Do(req, schema)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schema, resp, err
+}
+
+type AddLabelValueOptions struct {
+	Name string `json:"name"`
+}
+
+// AddLabelValue adds value to the label key with given id.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/labels/#add-label-value
+func (service *LabelService) AddLabelValue(keyID string, opt *AddLabelValueOptions) (*LabelSchema, *http.Response, error) {
+	u := fmt.Sprintf("%s/id/%s/values/", service.url, keyID)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Labels.AddLabelValue", keyID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := new(LabelSchema)
+	resp, err := service.client.Do(req, schema)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return schema, resp, err
+}
+
+// ResolveLabels checks that keys and values of all labels exist and sets their IDs and names.
+// Keys and values are looked up by ID when set, by name otherwise.
+// It returns an error wrapping ErrNotFound for the first missing key or value.
+func (service *LabelService) ResolveLabels(labels []*Label) (*http.Response, error) {
+	return service.resolveLabels(labels, true)
+}
+
+// ResolveLabelKeys checks that keys of all labels exist and sets their IDs and names,
+// leaving values as they are. It suits dynamic labels, whose values are templates.
+func (service *LabelService) ResolveLabelKeys(labels []*Label) (*http.Response, error) {
+	return service.resolveLabels(labels, false)
+}
+
+func (service *LabelService) resolveLabels(labels []*Label, withValues bool) (*http.Response, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	keys, resp, err := service.ListLabelKeys()
+	if err != nil {
+		return resp, err
+	}
+
+	schemas := make(map[string]*LabelSchema)
+	for _, label := range labels {
+		key := findKeyValueName(keys, label.Key)
+		if key == nil {
+			return resp, fmt.Errorf("label key %s: %w", describeKeyValueName(label.Key), ErrNotFound)
+		}
+		if !withValues {
+			label.Key = *key
+			continue
+		}
+
+		schema, ok := schemas[key.ID]
+		if !ok {
+			schema, resp, err = service.GetLabelKey(key.ID)
+			if err != nil {
+				return resp, err
+			}
+			schemas[key.ID] = schema
+		}
+
+		value := findKeyValueName(schema.Values, label.Value)
+		if value == nil {
+			return resp, fmt.Errorf("label value %s of key %q: %w", describeKeyValueName(label.Value), key.Name, ErrNotFound)
+		}
+
+		label.Key, label.Value = *key, *value
+	}
+
+	return resp, nil
+}
+
+// findKeyValueName returns the item matching ref by ID when it is set, by name otherwise.
+func findKeyValueName(items []*KeyValueName, ref KeyValueName) *KeyValueName {
+	for _, item := range items {
+		if (ref.ID != "" && item.ID == ref.ID) || (ref.ID == "" && item.Name == ref.Name) {
+			return item
+		}
+	}
+	return nil
+}
+
+func describeKeyValueName(ref KeyValueName) string {
+	if ref.ID != "" {
+		return fmt.Sprintf("with id %q", ref.ID)
+	}
+	return fmt.Sprintf("%q", ref.Name)
+}
@@ -0,0 +1,259 @@
+package aapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testLabelSchemaBody = `{
+	"key": {"id": "KEY1", "name": "severity"},
+	"values": [
+		{"id": "VAL1", "name": "critical"},
+		{"id": "VAL2", "name": "warning"}
+	]
+}`
+
+var testLabelSchema = &LabelSchema{
+	Key: KeyValueName{ID: "KEY1", Name: "severity"},
+	Values: []*KeyValueName{
+		{ID: "VAL1", Name: "critical"},
+		{ID: "VAL2", Name: "warning"},
+	},
+}
+
+func TestListLabelKeys(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/keys/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": "KEY1", "name": "severity"}, {"id": "KEY2", "name": "env"}]`)
+	})
+
+	keys, _, err := client.Labels.ListLabelKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*KeyValueName{{ID: "KEY1", Name: "severity"}, {ID: "KEY2", Name: "env"}}
+	if !reflect.DeepEqual(want, keys) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", keys, want)
+	}
+}
+
+func TestGetLabelKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/id/KEY1/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, testLabelSchemaBody)
+	})
+
+	schema, _, err := client.Labels.GetLabelKey("KEY1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testLabelSchema, schema) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", schema, testLabelSchema)
+	}
+}
+
+func TestCreateLabelKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testLabelSchemaBody)
+	})
+
+	schema, _, err := client.Labels.CreateLabelKey(&CreateLabelKeyOptions{
+		Key:    KeyValueName{Name: "severity"},
+		Values: []KeyValueName{{Name: "critical"}, {Name: "warning"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testLabelSchema, schema) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", schema, testLabelSchema)
+	}
+}
+
+func TestAddLabelValue(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/id/KEY1/values/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testLabelSchemaBody)
+	})
+
+	schema, _, err := client.Labels.AddLabelValue("KEY1", &AddLabelValueOptions{Name: "warning"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testLabelSchema, schema) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", schema, testLabelSchema)
+	}
+}
+
+func TestResolveLabels(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/keys/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "KEY1", "name": "severity"}]`)
+	})
+	schemaRequests := 0
+	mux.HandleFunc("/api/v1/labels/id/KEY1/", func(w http.ResponseWriter, r *http.Request) {
+		schemaRequests++
+		fmt.Fprint(w, testLabelSchemaBody)
+	})
+
+	labels := []*Label{NewLabel("severity", "critical"), NewLabel("severity", "warning")}
+	if _, err := client.Labels.ResolveLabels(labels); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*Label{
+		{Key: KeyValueName{ID: "KEY1", Name: "severity"}, Value: KeyValueName{ID: "VAL1", Name: "critical"}},
+		{Key: KeyValueName{ID: "KEY1", Name: "severity"}, Value: KeyValueName{ID: "VAL2", Name: "warning"}},
+	}
+	if !reflect.DeepEqual(want, labels) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", labels, want)
+	}
+	if schemaRequests != 1 {
+		t.Errorf("fetched the label key %d times, want 1", schemaRequests)
+	}
+
+	byID := &Label{Key: KeyValueName{ID: "KEY1"}, Value: KeyValueName{ID: "VAL2"}}
+	if _, err := client.Labels.ResolveLabels([]*Label{byID}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want[1], byID) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", byID, want[1])
+	}
+
+	for _, label := range []*Label{NewLabel("env", "prod"), NewLabel("severity", "info"), {Key: KeyValueName{ID: "KEY2"}, Value: KeyValueName{ID: "VAL1"}}} {
+		if _, err := client.Labels.ResolveLabels([]*Label{label}); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: expected ErrNotFound, got %v", label.Filter(), err)
+		}
+	}
+}
+
+func TestLabelFilters(t *testing.T) {
+	filters := LabelFilters(NewLabel("env", "prod"), NewLabel("severity", "high"))
+	if want := []string{"env:prod", "severity:high"}; !reflect.DeepEqual(want, filters) {
+		t.Errorf("returned %v, want %v", filters, want)
+	}
+
+	label, err := ParseLabelFilter("team:sre:oncall")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := NewLabel("team", "sre:oncall"); !reflect.DeepEqual(want, label) {
+		t.Errorf("returned %+v, want %+v", label, want)
+	}
+
+	for _, filter := range []string{"env", ":prod", "env:"} {
+		if _, err := ParseLabelFilter(filter); err == nil {
+			t.Errorf("%q: expected error", filter)
+		}
+	}
+}
+
+func TestCreateIntegrationLabelValidation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/integrations/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testIntegrationBody)
+	})
+
+	invalid := []*CreateIntegrationOptions{
+		{Name: "Test Grafana", Type: "grafana", Labels: []*Label{NewLabel("severity", "")}},
+		{Name: "Test Grafana", Type: "grafana", DynamicLabels: []*Label{NewLabel("", "{{ payload.severity }}")}},
+	}
+	for _, createOptions := range invalid {
+		if _, _, err := client.Integrations.CreateIntegration(createOptions); err == nil {
+			t.Errorf("expected error for %+v", createOptions)
+		}
+	}
+
+	createOptions := &CreateIntegrationOptions{
+		Name:   "Test Grafana",
+		Type:   "grafana",
+		Labels: []*Label{{Key: KeyValueName{ID: "KEY1"}, Value: KeyValueName{ID: "VAL1"}}},
+	}
+	if _, _, err := client.Integrations.CreateIntegration(createOptions); err != nil {
+		t.Errorf("unexpected error for labels given by ID: %v", err)
+	}
+
+	label := &Label{Key: KeyValueName{ID: "KEY1"}}
+	want := `invalid label (key with id "KEY1", value ""): key and value IDs or names are required`
+	if err := label.Validate(); err == nil || err.Error() != want {
+		t.Errorf("returned error %v, want %q", err, want)
+	}
+}
+
+func TestCreateIntegrationWithLabelCheck(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/keys/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "KEY1", "name": "severity"}]`)
+	})
+	mux.HandleFunc("/api/v1/labels/id/KEY1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testLabelSchemaBody)
+	})
+	created := 0
+	mux.HandleFunc("/api/v1/integrations/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		created++
+		var body CreateIntegrationOptions
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		wantLabels := []*Label{{Key: KeyValueName{ID: "KEY1", Name: "severity"}, Value: KeyValueName{ID: "VAL1", Name: "critical"}}}
+		if !reflect.DeepEqual(wantLabels, body.Labels) {
+			t.Errorf("sent labels %+v, want %+v", body.Labels, wantLabels)
+		}
+		wantDynamicLabels := []*Label{{Key: KeyValueName{ID: "KEY1", Name: "severity"}, Value: KeyValueName{Name: "{{ payload.severity }}"}}}
+		if !reflect.DeepEqual(wantDynamicLabels, body.DynamicLabels) {
+			t.Errorf("sent dynamic labels %+v, want %+v", body.DynamicLabels, wantDynamicLabels)
+		}
+		fmt.Fprint(w, testIntegrationBody)
+	})
+
+	createOptions := &CreateIntegrationOptions{
+		Name:          "Test Grafana",
+		Type:          "grafana",
+		Labels:        []*Label{NewLabel("severity", "critical")},
+		DynamicLabels: []*Label{NewLabel("severity", "{{ payload.severity }}")},
+	}
+	if _, _, err := client.Integrations.CreateIntegrationWithLabelCheck(createOptions); err != nil {
+		t.Fatal(err)
+	}
+
+	missing := []*CreateIntegrationOptions{
+		{Name: "Test Grafana", Type: "grafana", Labels: []*Label{NewLabel("severity", "info")}},
+		{Name: "Test Grafana", Type: "grafana", DynamicLabels: []*Label{NewLabel("env", "{{ payload.env }}")}},
+	}
+	for _, createOptions := range missing {
+		if _, _, err := client.Integrations.CreateIntegrationWithLabelCheck(createOptions); !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("created %d integrations, want 1", created)
+	}
+}
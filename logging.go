@@ -0,0 +1,115 @@
+package aapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Logger is a structured, leveled logger. Arguments are alternating keys and values,
+// so *slog.Logger can be used directly. It also satisfies retryablehttp.LeveledLogger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// SetLogger makes the client log every API call to given logger, including retries
+// performed by the underlying retryablehttp client. Passing nil disables logging.
+// It should be called before the client is used.
+func (c *Client) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = logger
+	c.client.Logger = logger
+}
+
+// SetLogBodies enables debug dumps of request and response bodies with
+// credentials redacted. It has no effect unless a logger is set.
+func (c *Client) SetLogBodies(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logBodies = enabled
+}
+
+func (c *Client) loggerSettings() (Logger, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.logger, c.logBodies
+}
+
+// requestStats is attached to the request context by Client.Do and
+// updated by the transport on every attempt.
+type requestStats struct {
+	attempts int32
+}
+
+type requestStatsKey struct{}
+
+func withRequestStats(req *retryablehttp.Request) *retryablehttp.Request {
+	if _, ok := req.Context().Value(requestStatsKey{}).(*requestStats); ok {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), requestStatsKey{}, &requestStats{}))
+}
+
+func countAttempt(ctx context.Context) {
+	if stats, ok := ctx.Value(requestStatsKey{}).(*requestStats); ok {
+		atomic.AddInt32(&stats.attempts, 1)
+	}
+}
+
+// Retries returns how many times the request with given context was retried so far.
+func Retries(ctx context.Context) int {
+	stats, ok := ctx.Value(requestStatsKey{}).(*requestStats)
+	if !ok {
+		return 0
+	}
+	if attempts := atomic.LoadInt32(&stats.attempts); attempts > 1 {
+		return int(attempts) - 1
+	}
+	return 0
+}
+
+// logRequest writes a single record describing a finished API call.
+func logRequest(logger Logger, logBodies bool, req *retryablehttp.Request, resp *http.Response, err error, duration time.Duration) {
+	args := []interface{}{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"duration", duration,
+		"retries", Retries(req.Context()),
+	}
+	if resp != nil {
+		args = append(args, "status", resp.StatusCode)
+	}
+
+	if logBodies {
+		if body, bodyErr := req.BodyBytes(); bodyErr == nil && len(body) > 0 {
+			args = append(args, "request_body", redactBody(body))
+		}
+		if resp != nil && resp.Body != nil {
+			body, bodyErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if bodyErr == nil && len(body) > 0 {
+				args = append(args, "response_body", redactBody(body))
+			}
+		}
+	}
+
+	if err != nil {
+		logger.Error("request failed", append(args, "error", err)...)
+		return
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		logger.Warn("request completed", args...)
+		return
+	}
+	logger.Debug("request completed", args...)
+}
@@ -0,0 +1,146 @@
+package aapi
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testLogRecord struct {
+	level string
+	msg   string
+	args  map[string]interface{}
+}
+
+type testLogger struct {
+	mu      sync.Mutex
+	records []testLogRecord
+}
+
+func (l *testLogger) log(level, msg string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record := testLogRecord{level: level, msg: msg, args: map[string]interface{}{}}
+	for i := 0; i+1 < len(args); i += 2 {
+		record.args[fmt.Sprint(args[i])] = args[i+1]
+	}
+	l.records = append(l.records, record)
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("debug", msg, args...) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("info", msg, args...) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("warn", msg, args...) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("error", msg, args...) }
+
+func (l *testLogger) find(msg string) *testLogRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range l.records {
+		if l.records[i].msg == msg {
+			return &l.records[i]
+		}
+	}
+	return nil
+}
+
+func TestLogRequest(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	attempts := 0
+	mux.HandleFunc("/api/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "KGEFG74LU1D8L", "password": "response-secret"}`)
+	})
+
+	logger := &testLogger{}
+	client.SetLogger(logger)
+	client.SetLogBodies(true)
+
+	_, _, err := client.Webhooks.CreateWebhook(&CreateWebhookOptions{Name: "Test Webhook", Password: NewSecret("request-secret")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := logger.find("request completed")
+	if record == nil {
+		t.Fatal("Expected request to be logged")
+	}
+
+	if record.args["method"] != "POST" || record.args["path"] != "/api/v1/webhooks/" {
+		t.Errorf("Unexpected method and path: %v %v", record.args["method"], record.args["path"])
+	}
+	if record.args["status"] != http.StatusCreated {
+		t.Errorf("Status is %v, want %d", record.args["status"], http.StatusCreated)
+	}
+	if record.args["retries"] != 1 {
+		t.Errorf("Retries is %v, want 1", record.args["retries"])
+	}
+
+	for _, key := range []string{"request_body", "response_body"} {
+		body := fmt.Sprint(record.args[key])
+		if strings.Contains(body, "secret") || !strings.Contains(body, redacted) {
+			t.Errorf("%s is not redacted: %s", key, body)
+		}
+	}
+}
+
+func TestLogRequestFailure(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/routes/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"detail": "error"}`)
+	})
+
+	logger := &testLogger{}
+	client.SetLogger(logger)
+
+	_, _, err := client.Routes.CreateRoute(&CreateRouteOptions{})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	record := logger.find("request completed")
+	if record == nil {
+		t.Fatal("Expected request to be logged")
+	}
+	if record.level != "warn" || record.args["status"] != http.StatusBadRequest {
+		t.Errorf("Logged %s with status %v, want warn with %d", record.level, record.args["status"], http.StatusBadRequest)
+	}
+	if _, ok := record.args["request_body"]; ok {
+		t.Error("Bodies should not be logged by default")
+	}
+}
+
+func TestNoLoggerByDefault(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/teams/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	if _, _, err := client.Teams.ListTeams(&ListTeamOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("unexpected log output without a logger: %s", buf.String())
+	}
+}
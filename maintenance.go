@@ -0,0 +1,82 @@
+package aapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Maintenance modes of integrations.
+const (
+	// MaintenanceModeDebug keeps creating alert groups but doesn't notify anyone.
+	MaintenanceModeDebug = "debug"
+	// MaintenanceModeMaintenance collects all alerts into a single alert group.
+	MaintenanceModeMaintenance = "maintenance"
+)
+
+type startMaintenanceOptions struct {
+	Mode     string `json:"mode"`
+	Duration int    `json:"duration"`
+}
+
+// StartMaintenance puts the integration in given maintenance mode for duration,
+// rounded down to whole seconds.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/integrations/#start-maintenance
+func (service *IntegrationService) StartMaintenance(id string, mode string, duration time.Duration) (*Integration, *http.Response, error) {
+	if mode != MaintenanceModeDebug && mode != MaintenanceModeMaintenance {
+		return nil, nil, fmt.Errorf("invalid maintenance mode %q. Expected %q or %q", mode, MaintenanceModeDebug, MaintenanceModeMaintenance)
+	}
+	if duration < time.Second {
+		return nil, nil, fmt.Errorf("invalid maintenance duration %v. Expected at least 1s", duration)
+	}
+
+	u := fmt.Sprintf("%s/%s/maintenance_start/", service.url, id)
+
+	opt := &startMaintenanceOptions{Mode: mode, Duration: int(duration / time.Second)}
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Integrations.StartMaintenance", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	integration := new(Integration)
+	resp, err := service.client.Do(req, integration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return integration, resp, err
+}
+
+// StopMaintenance stops maintenance of the integration.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/integrations/#stop-maintenance
+func (service *IntegrationService) StopMaintenance(id string) (*http.Response, error) {
+	u := fmt.Sprintf("%s/%s/maintenance_stop/", service.url, id)
+
+	req, err := service.client.NewRequest("POST", u, nil, WithOperation("Integrations.StopMaintenance", id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.client.Do(req, nil)
+	return resp, err
+}
+
+// WithMaintenance runs fn while the integration is in given maintenance mode.
+// Maintenance is stopped when fn returns or panics, even if duration has not passed yet.
+// Errors of fn and of stopping the maintenance are joined.
+func (service *IntegrationService) WithMaintenance(id string, mode string, duration time.Duration, fn func() error) (err error) {
+	if _, _, err := service.StartMaintenance(id, mode, duration); err != nil {
+		return fmt.Errorf("failed to start maintenance of integration %s: %w", id, err)
+	}
+
+	defer func() {
+		if _, stopErr := service.StopMaintenance(id); stopErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to stop maintenance of integration %s: %w", id, stopErr))
+		}
+	}()
+
+	return fn()
+}
@@ -0,0 +1,91 @@
+package aapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testMaintenanceIntegrationBody = `{
+	"id": "CFRPV98RPR1U8",
+	"team_id": "T3HRAP3K3IKOP",
+	"name": "Test Grafana",
+	"maintenance_mode": "maintenance",
+	"maintenance_started_at": "2023-08-18T16:00:00Z",
+	"maintenance_end_at": "2023-08-18T17:00:00Z"
+}`
+
+func TestStartMaintenance(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/integrations/CFRPV98RPR1U8/maintenance_start/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"mode":"maintenance","duration":3600}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, testMaintenanceIntegrationBody)
+	})
+
+	integration, _, err := client.Integrations.StartMaintenance("CFRPV98RPR1U8", MaintenanceModeMaintenance, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mode, startedAt, endAt := "maintenance", "2023-08-18T16:00:00Z", "2023-08-18T17:00:00Z"
+	want := &Integration{
+		ID:                   "CFRPV98RPR1U8",
+		TeamId:               "T3HRAP3K3IKOP",
+		Name:                 "Test Grafana",
+		MaintenanceMode:      &mode,
+		MaintenanceStartedAt: &startedAt,
+		MaintenanceEndAt:     &endAt,
+	}
+	if !reflect.DeepEqual(want, integration) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", integration, want)
+	}
+}
+
+func TestStartMaintenanceValidation(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	if _, _, err := client.Integrations.StartMaintenance("CFRPV98RPR1U8", "silence", time.Hour); err == nil {
+		t.Error("expected error for invalid mode")
+	}
+	if _, _, err := client.Integrations.StartMaintenance("CFRPV98RPR1U8", MaintenanceModeDebug, 0); err == nil {
+		t.Error("expected error for zero duration")
+	}
+}
+
+func TestWithMaintenance(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls []string
+	mux.HandleFunc("/api/v1/integrations/CFRPV98RPR1U8/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		calls = append(calls, strings.TrimPrefix(r.URL.Path, "/api/v1/integrations/CFRPV98RPR1U8/"))
+		fmt.Fprint(w, testMaintenanceIntegrationBody)
+	})
+
+	fnErr := errors.New("deploy failed")
+	err := client.Integrations.WithMaintenance("CFRPV98RPR1U8", MaintenanceModeDebug, time.Hour, func() error {
+		calls = append(calls, "fn")
+		return fnErr
+	})
+	if !errors.Is(err, fnErr) {
+		t.Errorf("err = %v, want %v", err, fnErr)
+	}
+
+	want := []string{"maintenance_start/", "fn", "maintenance_stop/"}
+	if !reflect.DeepEqual(want, calls) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,100 @@
+package aapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const defaultRequestIDHeader = "X-Request-ID"
+
+// Doer sends an API request and returns the raw API response.
+// *retryablehttp.Client is the innermost Doer of every client.
+type Doer interface {
+	Do(req *retryablehttp.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to allow the use of ordinary functions as Doer.
+type DoerFunc func(req *retryablehttp.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *retryablehttp.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer to inject behaviour before a request is sent
+// or after its response is received. A middleware may also answer a
+// request without calling next.
+type Middleware func(next Doer) Doer
+
+// Use adds middlewares to the chain every request sent by Client.Do goes through.
+// Middlewares run in the order they were added, the first one being the outermost.
+// They see a single call per request, retries happen further down the chain.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// doer builds the middleware chain around the retryablehttp client.
+// Dry-run mode and the response cache, when enabled, sit right above the retryablehttp client.
+func (c *Client) doer() Doer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var doer Doer = c.client
+	if c.dryRun {
+		doer = c.dryRunDoer(doer)
+	}
+	if c.cache != nil {
+		doer = c.cachingDoer(c.cache, doer)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	return doer
+}
+
+// RequestIDMiddleware sets a random request ID in given header of every request
+// which does not have one yet. X-Request-ID is used when header is empty.
+func RequestIDMiddleware(header string) Middleware {
+	if header == "" {
+		header = defaultRequestIDHeader
+	}
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			if req.Header.Get(header) == "" {
+				id, err := newRequestID()
+				if err != nil {
+					return nil, err
+				}
+				req.Header.Set(header, id)
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// TimingMiddleware calls observe with the outcome and the duration of every request,
+// including time spent on retries.
+func TimingMiddleware(observe func(req *retryablehttp.Request, resp *http.Response, err error, duration time.Duration)) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.Do(req)
+			observe(req, resp, err, time.Since(start))
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package aapi
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		if got := strings.Join(r.Header.Values("X-Test"), ","); got != "outer,inner" {
+			t.Errorf("X-Test header is %q, want outer,inner", got)
+		}
+	})
+
+	var calls []string
+	tag := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				req.Header.Add("X-Test", name)
+				return next.Do(req)
+			})
+		}
+	}
+	client.Use(tag("outer"), tag("inner"))
+
+	req, err := client.NewRequest("GET", "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Del("X-Test")
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"outer", "inner"}; !reflect.DeepEqual(want, calls) {
+		t.Errorf("Middlewares called in order %v, want %v", calls, want)
+	}
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	client.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			return &http.Response{Request: req.Request, StatusCode: http.StatusServiceUnavailable}, nil
+		})
+	})
+
+	_, resp, err := client.Teams.GetTeam("T3HRAP3K3IKOP", &GetTeamOptions{})
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected injected response, got %v", resp)
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var requestID string
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get("X-Request-ID")
+	})
+
+	client.Use(RequestIDMiddleware(""))
+
+	req, err := client.NewRequest("GET", "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requestID) != 32 {
+		t.Errorf("Unexpected request ID %q", requestID)
+	}
+}
+
+func TestTimingMiddleware(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+	})
+
+	var observed time.Duration
+	var status int
+	client.Use(TimingMiddleware(func(req *retryablehttp.Request, resp *http.Response, err error, duration time.Duration) {
+		observed = duration
+		status = resp.StatusCode
+	}))
+
+	req, err := client.NewRequest("GET", "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if observed < 10*time.Millisecond || status != http.StatusOK {
+		t.Errorf("Unexpected timing %s with status %d", observed, status)
+	}
+}
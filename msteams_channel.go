@@ -0,0 +1,75 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MSTeamsChannelService handles requests to MS Teams channel endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/msteams_channels/
+type MSTeamsChannelService struct {
+	client *Client
+	url    string
+}
+
+// NewMSTeamsChannelService creates MSTeamsChannelService with defined url
+func NewMSTeamsChannelService(client *Client) *MSTeamsChannelService {
+	msTeamsChannelService := MSTeamsChannelService{}
+	msTeamsChannelService.client = client
+	msTeamsChannelService.url = "msteams_channels"
+	return &msTeamsChannelService
+}
+
+type PaginatedMSTeamsChannelsResponse struct {
+	PaginatedResponse
+	MSTeamsChannels []*MSTeamsChannel `json:"results"`
+}
+
+// MSTeamsChannel is a MS Teams channel connected to the organization.
+// Its ID is what MSTeamsRoute expects.
+type MSTeamsChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	TeamName  string `json:"team_name"`
+	IsDefault bool   `json:"is_default"`
+}
+
+type ListMSTeamsChannelOptions struct {
+	ListOptions
+	Name string `url:"name,omitempty" json:"name,omitempty"`
+}
+
+// ListMSTeamsChannels gets all MS Teams channels for authorized organization
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/msteams_channels/#list-ms-teams-channels
+func (service *MSTeamsChannelService) ListMSTeamsChannels(opt *ListMSTeamsChannelOptions) (*PaginatedMSTeamsChannelsResponse, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("MSTeamsChannels.ListMSTeamsChannels", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var msTeamsChannels *PaginatedMSTeamsChannelsResponse
+	resp, err := service.client.Do(req, &msTeamsChannels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return msTeamsChannels, resp, err
+}
+
+// GetByName fetches the MS Teams channel with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one MS Teams channel matches.
+func (service *MSTeamsChannelService) GetByName(name string) (*MSTeamsChannel, *http.Response, error) {
+	opt := &ListMSTeamsChannelOptions{Name: name}
+	return findOneByName("MS Teams channel", name, func(page int) ([]*MSTeamsChannel, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListMSTeamsChannels(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.MSTeamsChannels, &result.PaginatedResponse, resp, nil
+	}, func(msTeamsChannel *MSTeamsChannel) string { return msTeamsChannel.Name })
+}
@@ -0,0 +1,52 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testMSTeamsChannel = &MSTeamsChannel{
+	ID:       "MCJ8A6DA7X8WP",
+	Name:     "Incidents",
+	TeamName: "SRE",
+}
+
+var testMSTeamsChannelBody = `{
+	"id": "MCJ8A6DA7X8WP",
+	"name": "Incidents",
+	"team_name": "SRE",
+	"is_default": false
+}`
+
+func TestListMSTeamsChannels(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/msteams_channels/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testMSTeamsChannelBody))
+	})
+
+	options := &ListMSTeamsChannelOptions{}
+
+	msTeamsChannels, _, err := client.MSTeamsChannels.ListMSTeamsChannels(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PaginatedMSTeamsChannelsResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count:    1,
+			Next:     nil,
+			Previous: nil,
+		},
+		MSTeamsChannels: []*MSTeamsChannel{
+			testMSTeamsChannel,
+		},
+	}
+	if !reflect.DeepEqual(want, msTeamsChannels) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", msTeamsChannels, want)
+	}
+}
@@ -59,7 +59,7 @@ type ListOnCallShiftOptions struct {
 func (service *OnCallShiftService) ListOnCallShifts(opt *ListOnCallShiftOptions) (*PaginatedOnCallShiftsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("OnCallShifts.ListOnCallShifts", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -82,7 +82,7 @@ type GetOnCallShiftOptions struct {
 func (service *OnCallShiftService) GetOnCallShift(id string, opt *GetOnCallShiftOptions) (*OnCallShift, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("OnCallShifts.GetOnCallShift", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -122,7 +122,7 @@ type CreateOnCallShiftOptions struct {
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/on_call_shifts/#create-an-oncall-shift
 func (service *OnCallShiftService) CreateOnCallShift(opt *CreateOnCallShiftOptions) (*OnCallShift, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("OnCallShifts.CreateOnCallShift", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -165,7 +165,7 @@ type UpdateOnCallShiftOptions struct {
 func (service *OnCallShiftService) UpdateOnCallShift(id string, opt *UpdateOnCallShiftOptions) (*OnCallShift, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("OnCallShifts.UpdateOnCallShift", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +189,7 @@ func (service *OnCallShiftService) DeleteOnCallShift(id string, opt *DeleteOnCal
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("OnCallShifts.DeleteOnCallShift", id))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,43 @@
+package aapi
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RequestOption customizes a request created by NewRequest.
+type RequestOption func(*retryablehttp.Request) error
+
+// WithOperation labels the request with the API call it is made for, e.g. "Integrations.UpdateIntegration",
+// and the ID of the resource it refers to, empty for list and create calls.
+// Middlewares read them with Operation and ResourceID.
+func WithOperation(operation, resourceID string) RequestOption {
+	return func(req *retryablehttp.Request) error {
+		info := requestInfo{operation: operation, resourceID: resourceID}
+		req.Request = req.Request.WithContext(context.WithValue(req.Context(), requestInfoKey{}, info))
+		return nil
+	}
+}
+
+// requestInfo describes which API call a request was created for.
+type requestInfo struct {
+	operation  string
+	resourceID string
+}
+
+type requestInfoKey struct{}
+
+// Operation returns the name of the API call the request with given context was created for,
+// e.g. "Integrations.UpdateIntegration". It is empty for requests not labeled with WithOperation.
+func Operation(ctx context.Context) string {
+	info, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+	return info.operation
+}
+
+// ResourceID returns the ID of the resource the request with given context refers to,
+// e.g. the integration ID for UpdateIntegration. It is empty for list and create calls.
+func ResourceID(ctx context.Context) string {
+	info, _ := ctx.Value(requestInfoKey{}).(requestInfo)
+	return info.resourceID
+}
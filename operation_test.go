@@ -0,0 +1,107 @@
+package aapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestOperation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/integrations/CFRPV98RPR1U8/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testIntegrationBody))
+	})
+
+	var operation, resourceID string
+	client.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			operation = Operation(req.Context())
+			resourceID = ResourceID(req.Context())
+			return next.Do(req)
+		})
+	})
+
+	if _, _, err := client.Integrations.UpdateIntegration("CFRPV98RPR1U8", &UpdateIntegrationOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if operation != "Integrations.UpdateIntegration" {
+		t.Errorf("Operation is %q, want Integrations.UpdateIntegration", operation)
+	}
+	if resourceID != "CFRPV98RPR1U8" {
+		t.Errorf("ResourceID is %q, want CFRPV98RPR1U8", resourceID)
+	}
+}
+
+func TestOperationOutsideService(t *testing.T) {
+	c, err := New("base_url", "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if operation := Operation(req.Context()); operation != "" {
+		t.Errorf("Operation is %q, want it empty", operation)
+	}
+}
+
+func TestOperationLabelKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/labels/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/api/v1/labels/id/1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": {"id": "1", "name": "severity"}, "values": []}`))
+	})
+
+	var operations, resourceIDs []string
+	client.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			operations = append(operations, Operation(req.Context()))
+			resourceIDs = append(resourceIDs, ResourceID(req.Context()))
+			return next.Do(req)
+		})
+	})
+
+	if _, _, err := client.Labels.GetLabelKey("1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Labels.ListLabelKeys(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOperations := []string{"Labels.GetLabelKey", "Labels.ListLabelKeys"}
+	wantResourceIDs := []string{"1", ""}
+	if !reflect.DeepEqual(operations, wantOperations) || !reflect.DeepEqual(resourceIDs, wantResourceIDs) {
+		t.Errorf("got operations %q with resource IDs %q, want %q with %q", operations, resourceIDs, wantOperations, wantResourceIDs)
+	}
+}
+
+func TestWithOperation(t *testing.T) {
+	c, err := New("base_url", "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "test/42/", nil, WithOperation("Custom.Get", "42"))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if operation := Operation(req.Context()); operation != "Custom.Get" {
+		t.Errorf("Operation is %q, want Custom.Get", operation)
+	}
+	if resourceID := ResourceID(req.Context()); resourceID != "42" {
+		t.Errorf("ResourceID is %q, want 42", resourceID)
+	}
+}
@@ -0,0 +1,93 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// OrganizationService handles requests to organization endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/organizations/
+type OrganizationService struct {
+	client *Client
+	url    string
+}
+
+// NewOrganizationService creates OrganizationService with corresponding url part
+func NewOrganizationService(client *Client) *OrganizationService {
+	organizationService := OrganizationService{}
+	organizationService.client = client
+	organizationService.url = "organizations"
+	return &organizationService
+}
+
+type PaginatedOrganizationsResponse struct {
+	PaginatedResponse
+	Organizations []*Organization `json:"results"`
+}
+
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ListOrganizationOptions struct {
+	ListOptions
+}
+
+// ListOrganizations fetches organizations the token has access to. It is always a single organization.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/organizations/#list-organizations
+func (service *OrganizationService) ListOrganizations(opt *ListOrganizationOptions) (*PaginatedOrganizationsResponse, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Organizations.ListOrganizations", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var organizations *PaginatedOrganizationsResponse
+	resp, err := service.client.Do(req, &organizations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return organizations, resp, err
+}
+
+type GetOrganizationOptions struct {
+}
+
+// GetOrganization fetches organization by given id.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/organizations/#get-an-organization
+func (service *OrganizationService) GetOrganization(id string, opt *GetOrganizationOptions) (*Organization, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Organizations.GetOrganization", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	organization := new(Organization)
+	resp, err := service.client.Do(req, organization)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return organization, resp, err
+}
+
+// GetCurrentOrganization fetches the organization the token belongs to.
+func (service *OrganizationService) GetCurrentOrganization() (*Organization, *http.Response, error) {
+	organizations, resp, err := service.ListOrganizations(&ListOrganizationOptions{})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(organizations.Organizations) == 0 {
+		return nil, resp, fmt.Errorf("organization: %w", ErrNotFound)
+	}
+
+	return organizations.Organizations[0], resp, nil
+}
@@ -0,0 +1,66 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testOrganization = &Organization{
+	ID:   "O53AAGWFBPE5W",
+	Name: "Test Org",
+}
+
+var testOrganizationBody = `{
+	"id": "O53AAGWFBPE5W",
+	"name": "Test Org"
+}`
+
+func TestListOrganizations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/organizations/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testOrganizationBody))
+	})
+
+	organizations, _, err := client.Organizations.ListOrganizations(&ListOrganizationOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PaginatedOrganizationsResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count:    1,
+			Next:     nil,
+			Previous: nil,
+		},
+		Organizations: []*Organization{
+			testOrganization,
+		},
+	}
+	if !reflect.DeepEqual(want, organizations) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", organizations, want)
+	}
+}
+
+func TestGetOrganization(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/organizations/O53AAGWFBPE5W/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, testOrganizationBody)
+	})
+
+	organization, _, err := client.Organizations.GetOrganization("O53AAGWFBPE5W", &GetOrganizationOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testOrganization, organization) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", organization, testOrganization)
+	}
+}
@@ -0,0 +1,148 @@
+// Package otelaapi instruments aapi clients with OpenTelemetry tracing and metrics.
+//
+// Instrumentation is opt-in and installed as a client middleware:
+//
+//	client.Use(otelaapi.Middleware())
+//
+// By default the global tracer and meter providers are used, which are no-op
+// unless configured by the application. otelaapi is a separate module, so the
+// core client does not depend on OpenTelemetry.
+//
+// Its tests are not run by go test ./... in the repository root. To run them
+// against the client in the same tree, use a local, uncommitted workspace:
+//
+//	go work init . ./otelaapi
+//	go test ./otelaapi/...
+package otelaapi
+
+import (
+	"net/http"
+	"time"
+
+	aapi "github.com/grafana/amixr-api-go-client"
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/grafana/amixr-api-go-client/otelaapi"
+	spanNamePrefix      = "aapi."
+)
+
+// Attribute keys recorded on spans and metrics.
+const (
+	OperationKey  = attribute.Key("aapi.operation")
+	ResourceIDKey = attribute.Key("aapi.resource.id")
+	RetriesKey    = attribute.Key("aapi.retries")
+	MethodKey     = attribute.Key("http.request.method")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	URLPathKey    = attribute.Key("url.path")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider used to create spans instead of the global one.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider used to create instruments instead of the global one.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// Middleware returns an aapi.Middleware which creates a client span per API call,
+// named after the service method (e.g. "aapi.Integrations.UpdateIntegration"),
+// and records request count and latency.
+func Middleware(opts ...Option) aapi.Middleware {
+	cfg := config{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.tracerProvider == nil {
+		cfg.tracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = otel.GetMeterProvider()
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	requests, err := meter.Int64Counter("aapi.client.requests",
+		metric.WithDescription("Number of API calls made by the client."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	duration, err := meter.Float64Histogram("aapi.client.request.duration",
+		metric.WithDescription("Duration of API calls made by the client, including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return func(next aapi.Doer) aapi.Doer {
+		return aapi.DoerFunc(func(req *retryablehttp.Request) (*http.Response, error) {
+			operation := aapi.Operation(req.Context())
+			spanName := spanNamePrefix + operation
+			if operation == "" {
+				spanName = spanNamePrefix + req.Method
+			}
+
+			attrs := []attribute.KeyValue{
+				OperationKey.String(operation),
+				MethodKey.String(req.Method),
+			}
+
+			ctx, span := tracer.Start(req.Context(), spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+				trace.WithAttributes(URLPathKey.String(req.URL.Path)))
+			defer span.End()
+			if id := aapi.ResourceID(ctx); id != "" {
+				span.SetAttributes(ResourceIDKey.String(id))
+			}
+
+			start := time.Now()
+			resp, err := next.Do(req.WithContext(ctx))
+			elapsed := time.Since(start)
+
+			span.SetAttributes(RetriesKey.Int(aapi.Retries(ctx)))
+			switch {
+			case err != nil:
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			case resp.StatusCode >= http.StatusBadRequest:
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			}
+			if resp != nil {
+				attrs = append(attrs, StatusCodeKey.Int(resp.StatusCode))
+				span.SetAttributes(StatusCodeKey.Int(resp.StatusCode))
+			}
+
+			if requests != nil {
+				requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+			}
+			if duration != nil {
+				duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+			}
+
+			return resp, err
+		})
+	}
+}
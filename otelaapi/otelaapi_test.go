@@ -0,0 +1,159 @@
+package otelaapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	aapi "github.com/grafana/amixr-api-go-client"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setup(t *testing.T) (*http.ServeMux, *httptest.Server, *aapi.Client, *tracetest.InMemoryExporter, *sdkmetric.ManualReader) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	client, err := aapi.New(server.URL, "token")
+	if err != nil {
+		server.Close()
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	client.Use(Middleware(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	))
+
+	return mux, server, client, exporter, reader
+}
+
+func attributeValue(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestMiddlewareSpan(t *testing.T) {
+	mux, server, client, exporter, _ := setup(t)
+	defer server.Close()
+
+	attempts := 0
+	mux.HandleFunc("/api/v1/integrations/CFRPV98RPR1U8/", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id": "CFRPV98RPR1U8"}`)
+	})
+
+	if _, _, err := client.Integrations.UpdateIntegration("CFRPV98RPR1U8", &aapi.UpdateIntegrationOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	span := spans[0]
+	if span.Name != "aapi.Integrations.UpdateIntegration" {
+		t.Errorf("Span name is %q, want aapi.Integrations.UpdateIntegration", span.Name)
+	}
+
+	want := map[attribute.Key]attribute.Value{
+		OperationKey:  attribute.StringValue("Integrations.UpdateIntegration"),
+		ResourceIDKey: attribute.StringValue("CFRPV98RPR1U8"),
+		MethodKey:     attribute.StringValue("PUT"),
+		StatusCodeKey: attribute.IntValue(http.StatusOK),
+		RetriesKey:    attribute.IntValue(1),
+	}
+	for key, value := range want {
+		got, ok := attributeValue(span.Attributes, key)
+		if !ok || got != value {
+			t.Errorf("Attribute %s is %v, want %v", key, got.Emit(), value.Emit())
+		}
+	}
+}
+
+func TestMiddlewareSpanError(t *testing.T) {
+	mux, server, client, exporter, _ := setup(t)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/teams/T3HRAP3K3IKOP/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "not found"}`)
+	})
+
+	if _, _, err := client.Teams.GetTeam("T3HRAP3K3IKOP", &aapi.GetTeamOptions{}); err == nil {
+		t.Fatal("Expected error")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Errorf("Span status is %v, want error", spans[0].Status.Code)
+	}
+}
+
+func TestMiddlewareMetrics(t *testing.T) {
+	mux, server, client, _, reader := setup(t)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/teams/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "next": null, "previous": null, "results": []}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Teams.ListTeams(&aapi.ListTeamOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	found := map[string]bool{}
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			found[m.Name] = true
+			switch d := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				if len(d.DataPoints) != 1 || d.DataPoints[0].Value != 2 {
+					t.Errorf("Unexpected request count: %+v", d.DataPoints)
+				}
+				operation, _ := d.DataPoints[0].Attributes.Value(OperationKey)
+				if operation.AsString() != "Teams.ListTeams" {
+					t.Errorf("Operation attribute is %q, want Teams.ListTeams", operation.AsString())
+				}
+			case metricdata.Histogram[float64]:
+				if len(d.DataPoints) != 1 || d.DataPoints[0].Count != 2 {
+					t.Errorf("Unexpected latency histogram: %+v", d.DataPoints)
+				}
+			}
+		}
+	}
+
+	for _, name := range []string{"aapi.client.requests", "aapi.client.request.duration"} {
+		if !found[name] {
+			t.Errorf("Metric %s was not recorded", name)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package aapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles outgoing requests. Wait blocks until the next request
+// is allowed to be sent or ctx is done.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a RateLimiter which allows bursts of up to burst requests
+// and refills at rate requests per second.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a full TokenBucket with given rate (requests per second) and burst size.
+// Burst is at least 1. It panics if rate is not positive, as such a bucket would never refill.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if !(rate > 0) {
+		panic(fmt.Sprintf("aapi: non-positive token bucket rate %v", rate))
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token from the bucket, sleeping until one is available.
+// A token reserved by a cancelled Wait is returned to the bucket.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	tokens := b.tokens
+	b.mu.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Duration(-tokens / b.rate * float64(time.Second)))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RateLimit holds the rate limit information the API returned with a response.
+// Fields are zero when the corresponding header was missing.
+type RateLimit struct {
+	Limit      int
+	Remaining  int
+	Reset      time.Time
+	RetryAfter time.Duration
+}
+
+// ParseRateLimit reads Retry-After and X-RateLimit-* headers of the response.
+// X-RateLimit-Reset is accepted both as a unix timestamp and as a number of seconds from now.
+func ParseRateLimit(r *http.Response) RateLimit {
+	var rl RateLimit
+	if r == nil {
+		return rl
+	}
+
+	if v, err := strconv.Atoi(r.Header.Get("X-RateLimit-Limit")); err == nil {
+		rl.Limit = v
+	}
+	if v, err := strconv.Atoi(r.Header.Get("X-RateLimit-Remaining")); err == nil {
+		rl.Remaining = v
+	}
+	if v, err := strconv.ParseInt(r.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		// Anything before 2001-09-09 can not be a timestamp.
+		if v >= 1e9 {
+			rl.Reset = time.Unix(v, 0)
+		} else {
+			rl.Reset = time.Now().Add(time.Duration(v) * time.Second)
+		}
+	}
+	if d, ok := parseRetryAfter(r.Header.Get("Retry-After")); ok {
+		rl.RetryAfter = d
+	}
+
+	return rl
+}
+
+// parseRetryAfter parses Retry-After header given either in seconds or as HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	if until := time.Until(date); until > 0 {
+		return until, true
+	}
+	return 0, true
+}
+
+// rateLimitBackoff is used as retryablehttp backoff policy. On 429 and 503 responses
+// it waits for as long as the API asked to via Retry-After, or until X-RateLimit-Reset
+// when no requests remain. Otherwise it falls back to exponential backoff.
+func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		rl := ParseRateLimit(resp)
+		if resp.Header.Get("Retry-After") != "" {
+			return rl.RetryAfter
+		}
+		if !rl.Reset.IsZero() && rl.Remaining == 0 {
+			if until := time.Until(rl.Reset); until > 0 {
+				return until
+			}
+		}
+	}
+
+	sleep := time.Duration(math.Pow(2, float64(attemptNum)) * float64(min))
+	if sleep <= 0 || sleep > max {
+		sleep = max
+	}
+	return sleep
+}
+
+// transport wraps the http.RoundTripper of the underlying retryablehttp client.
+// It applies client rate limiter to every attempt, including retries, and
+// remembers the rate limit information of every response. It also counts
+// attempts made for each request.
+type transport struct {
+	client *Client
+	base   http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	countAttempt(req.Context())
+
+	if limiter := t.client.rateLimiter(); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		t.client.setRateLimit(ParseRateLimit(resp))
+	}
+	return resp, err
+}
+
+// SetRateLimiter throttles all requests sent by the client with given limiter.
+// Passing nil disables client-side throttling.
+func (c *Client) SetRateLimiter(limiter RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limiter = limiter
+}
+
+func (c *Client) rateLimiter() RateLimiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.limiter
+}
+
+// RateLimit returns rate limit information parsed from the last response received by the client.
+func (c *Client) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+func (c *Client) setRateLimit(rl RateLimit) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimit = rl
+}
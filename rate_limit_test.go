@@ -0,0 +1,118 @@
+package aapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(20, 2)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := bucket.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first 2 requests use the burst, the other 2 wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected throttling to take at least 90ms, took %s", elapsed)
+	}
+}
+
+func TestTokenBucketCancel(t *testing.T) {
+	bucket := NewTokenBucket(0.1, 1)
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestTokenBucketInvalidRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected NewTokenBucket(%v, 1) to panic", rate)
+				}
+			}()
+			NewTokenBucket(rate, 1)
+		}()
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Limit", "300")
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+	resp.Header.Set("Retry-After", "7")
+
+	rl := ParseRateLimit(resp)
+
+	if rl.Limit != 300 || rl.Remaining != 0 {
+		t.Errorf("Unexpected limits: %+v", rl)
+	}
+	if rl.Reset.Unix() != reset {
+		t.Errorf("Reset is %s, want %s", rl.Reset, time.Unix(reset, 0))
+	}
+	if rl.RetryAfter != 7*time.Second {
+		t.Errorf("RetryAfter is %s, want 7s", rl.RetryAfter)
+	}
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if wait := rateLimitBackoff(time.Second, 30*time.Second, 0, resp); wait != 3*time.Second {
+		t.Errorf("Expected to wait 3s, got %s", wait)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", "10")
+	if wait := rateLimitBackoff(time.Second, 30*time.Second, 0, resp); wait < 9*time.Second || wait > 10*time.Second {
+		t.Errorf("Expected to wait until reset, got %s", wait)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}}
+	if wait := rateLimitBackoff(time.Second, 30*time.Second, 2, resp); wait != 4*time.Second {
+		t.Errorf("Expected to wait 4s, got %s", wait)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "300")
+		w.Header().Set("X-RateLimit-Remaining", "299")
+	})
+
+	client.SetRateLimiter(NewTokenBucket(100, 1))
+
+	req, err := client.NewRequest("GET", "test", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if rl := client.RateLimit(); rl.Limit != 300 || rl.Remaining != 299 {
+		t.Errorf("Unexpected rate limit: %+v", rl)
+	}
+}
@@ -0,0 +1,151 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ResolutionNoteService handles requests to resolution note endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/
+type ResolutionNoteService struct {
+	client *Client
+	url    string
+}
+
+// NewResolutionNoteService creates ResolutionNoteService with corresponding url part
+func NewResolutionNoteService(client *Client) *ResolutionNoteService {
+	resolutionNoteService := ResolutionNoteService{}
+	resolutionNoteService.client = client
+	resolutionNoteService.url = "resolution_notes"
+	return &resolutionNoteService
+}
+
+type PaginatedResolutionNotesResponse struct {
+	PaginatedResponse
+	ResolutionNotes []*ResolutionNote `json:"results"`
+}
+
+type ResolutionNote struct {
+	ID           string  `json:"id"`
+	AlertGroupID string  `json:"alert_group_id"`
+	Author       *string `json:"author"`
+	Source       string  `json:"source"`
+	CreatedAt    string  `json:"created_at"`
+	Text         string  `json:"text"`
+}
+
+type ListResolutionNoteOptions struct {
+	ListOptions
+	AlertGroupID string `url:"alert_group_id,omitempty" json:"alert_group_id,omitempty"`
+}
+
+// ListResolutionNotes fetches all resolution notes for current organization.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/#list-resolution-notes
+func (service *ResolutionNoteService) ListResolutionNotes(opt *ListResolutionNoteOptions) (*PaginatedResolutionNotesResponse, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("ResolutionNotes.ListResolutionNotes", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resolutionNotes *PaginatedResolutionNotesResponse
+	resp, err := service.client.Do(req, &resolutionNotes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolutionNotes, resp, err
+}
+
+type GetResolutionNoteOptions struct {
+}
+
+// GetResolutionNote fetches resolution note by given id.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/#get-a-resolution-note
+func (service *ResolutionNoteService) GetResolutionNote(id string, opt *GetResolutionNoteOptions) (*ResolutionNote, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("ResolutionNotes.GetResolutionNote", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolutionNote := new(ResolutionNote)
+	resp, err := service.client.Do(req, resolutionNote)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolutionNote, resp, err
+}
+
+type CreateResolutionNoteOptions struct {
+	AlertGroupID string `json:"alert_group_id"`
+	Text         string `json:"text"`
+}
+
+// CreateResolutionNote creates resolution note with text for the alert group.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/#create-a-resolution-note
+func (service *ResolutionNoteService) CreateResolutionNote(opt *CreateResolutionNoteOptions) (*ResolutionNote, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("ResolutionNotes.CreateResolutionNote", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolutionNote := new(ResolutionNote)
+	resp, err := service.client.Do(req, resolutionNote)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolutionNote, resp, err
+}
+
+type UpdateResolutionNoteOptions struct {
+	Text string `json:"text"`
+}
+
+// UpdateResolutionNote updates resolution note text.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/#update-a-resolution-note
+func (service *ResolutionNoteService) UpdateResolutionNote(id string, opt *UpdateResolutionNoteOptions) (*ResolutionNote, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("ResolutionNotes.UpdateResolutionNote", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolutionNote := new(ResolutionNote)
+	resp, err := service.client.Do(req, resolutionNote)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolutionNote, resp, err
+}
+
+type DeleteResolutionNoteOptions struct {
+}
+
+// DeleteResolutionNote deletes resolution note.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/resolution_notes/#delete-a-resolution-note
+func (service *ResolutionNoteService) DeleteResolutionNote(id string, opt *DeleteResolutionNoteOptions) (*http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("ResolutionNotes.DeleteResolutionNote", id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.client.Do(req, nil)
+	return resp, err
+}
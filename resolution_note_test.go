@@ -0,0 +1,142 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testResolutionNoteAuthor = "UEXU7ZB2JM36M"
+
+var testResolutionNote = &ResolutionNote{
+	ID:           "M4BTQUS3PRHYQ",
+	AlertGroupID: "I68T24C13IFW1",
+	Author:       &testResolutionNoteAuthor,
+	Source:       "web",
+	CreatedAt:    "2020-06-19T12:40:01.429805Z",
+	Text:         "Demo resolution note",
+}
+
+var testResolutionNoteBody = `{
+	"id": "M4BTQUS3PRHYQ",
+	"alert_group_id": "I68T24C13IFW1",
+	"author": "UEXU7ZB2JM36M",
+	"source": "web",
+	"created_at": "2020-06-19T12:40:01.429805Z",
+	"text": "Demo resolution note"
+}`
+
+func TestListResolutionNotes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/resolution_notes/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("alert_group_id"); got != "I68T24C13IFW1" {
+			t.Errorf("alert_group_id = %q, want I68T24C13IFW1", got)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testResolutionNoteBody))
+	})
+
+	options := &ListResolutionNoteOptions{
+		AlertGroupID: "I68T24C13IFW1",
+	}
+
+	resolutionNotes, _, err := client.ResolutionNotes.ListResolutionNotes(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PaginatedResolutionNotesResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count:    1,
+			Next:     nil,
+			Previous: nil,
+		},
+		ResolutionNotes: []*ResolutionNote{
+			testResolutionNote,
+		},
+	}
+	if !reflect.DeepEqual(want, resolutionNotes) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", resolutionNotes, want)
+	}
+}
+
+func TestGetResolutionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/resolution_notes/M4BTQUS3PRHYQ/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, testResolutionNoteBody)
+	})
+
+	resolutionNote, _, err := client.ResolutionNotes.GetResolutionNote("M4BTQUS3PRHYQ", &GetResolutionNoteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testResolutionNote, resolutionNote) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", resolutionNote, testResolutionNote)
+	}
+}
+
+func TestCreateResolutionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/resolution_notes/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testResolutionNoteBody)
+	})
+
+	createOptions := &CreateResolutionNoteOptions{
+		AlertGroupID: "I68T24C13IFW1",
+		Text:         "Demo resolution note",
+	}
+	resolutionNote, _, err := client.ResolutionNotes.CreateResolutionNote(createOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testResolutionNote, resolutionNote) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", resolutionNote, testResolutionNote)
+	}
+}
+
+func TestUpdateResolutionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/resolution_notes/M4BTQUS3PRHYQ/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "PUT")
+		fmt.Fprint(w, testResolutionNoteBody)
+	})
+
+	updateOptions := &UpdateResolutionNoteOptions{
+		Text: "Demo resolution note",
+	}
+	resolutionNote, _, err := client.ResolutionNotes.UpdateResolutionNote("M4BTQUS3PRHYQ", updateOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testResolutionNote, resolutionNote) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", resolutionNote, testResolutionNote)
+	}
+}
+
+func TestDeleteResolutionNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/resolution_notes/M4BTQUS3PRHYQ/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "DELETE")
+	})
+
+	_, err := client.ResolutionNotes.DeleteResolutionNote("M4BTQUS3PRHYQ", &DeleteResolutionNoteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
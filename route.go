@@ -2,7 +2,6 @@ package aapi
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 )
 
@@ -66,7 +65,7 @@ type ListRouteOptions struct {
 func (service *RouteService) ListRoutes(opt *ListRouteOptions) (*PaginatedRoutesResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Routes.ListRoutes", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -89,7 +88,7 @@ type GetRouteOptions struct {
 func (service *RouteService) GetRoute(id string, opt *GetRouteOptions) (*Route, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Routes.GetRoute", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -120,7 +119,7 @@ type CreateRouteOptions struct {
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/routes/#create-a-route
 func (service *RouteService) CreateRoute(opt *CreateRouteOptions) (*Route, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Routes.CreateRoute", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,7 +127,6 @@ func (service *RouteService) CreateRoute(opt *CreateRouteOptions) (*Route, *http
 	route := new(Route)
 
 	resp, err := service.client.Do(req, route)
-	log.Printf("[DEBUG] request success")
 
 	if err != nil {
 		return nil, resp, err
@@ -154,7 +152,7 @@ type UpdateRouteOptions struct {
 func (service *RouteService) UpdateRoute(id string, opt *UpdateRouteOptions) (*Route, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("Routes.UpdateRoute", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -178,7 +176,7 @@ func (service *RouteService) DeleteRoute(id string, opt *DeleteRouteOptions) (*h
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("Routes.DeleteRoute", id))
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ type ListScheduleOptions struct {
 func (service *ScheduleService) ListSchedules(opt *ListScheduleOptions) (*PaginatedSchedulesResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Schedules.ListSchedules", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,6 +73,20 @@ func (service *ScheduleService) ListSchedules(opt *ListScheduleOptions) (*Pagina
 	return schedules, resp, err
 }
 
+// GetByName fetches the schedule with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one schedule matches.
+func (service *ScheduleService) GetByName(name string) (*Schedule, *http.Response, error) {
+	opt := &ListScheduleOptions{Name: name}
+	return findOneByName("schedule", name, func(page int) ([]*Schedule, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListSchedules(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.Schedules, &result.PaginatedResponse, resp, nil
+	}, func(schedule *Schedule) string { return schedule.Name })
+}
+
 type GetScheduleOptions struct {
 }
 
@@ -82,7 +96,7 @@ type GetScheduleOptions struct {
 func (service *ScheduleService) GetSchedule(id string, opt *GetScheduleOptions) (*Schedule, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Schedules.GetSchedule", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -113,7 +127,7 @@ type CreateScheduleOptions struct {
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/schedules/#create-a-schedule
 func (service *ScheduleService) CreateSchedule(opt *CreateScheduleOptions) (*Schedule, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Schedules.CreateSchedule", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,7 +160,7 @@ type UpdateScheduleOptions struct {
 func (service *ScheduleService) UpdateSchedule(id string, opt *UpdateScheduleOptions) (*Schedule, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("Schedules.UpdateSchedule", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,7 +184,7 @@ func (service *ScheduleService) DeleteSchedule(id string, opt *DeleteScheduleOpt
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("Schedules.DeleteSchedule", id))
 	if err != nil {
 		return nil, err
 	}
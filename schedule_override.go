@@ -0,0 +1,161 @@
+package aapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OnCallShiftTypeOverride is the type of on-call shifts overriding the regular rotation of a schedule.
+const OnCallShiftTypeOverride = "override"
+
+// onCallShiftTimeLayout is the layout of OnCallShift Start, interpreted in the shift TimeZone.
+const onCallShiftTimeLayout = "2006-01-02T15:04:05"
+
+// CreateOverride creates an override shift putting user on call in the schedule between start and end,
+// and attaches it to the schedule. The shift is deleted again when attaching it fails.
+func (service *ScheduleService) CreateOverride(scheduleID string, user string, start, end time.Time) (*OnCallShift, *http.Response, error) {
+	if !end.After(start) {
+		return nil, nil, fmt.Errorf("invalid override period: end %v is not after start %v", end, start)
+	}
+
+	schedule, resp, err := service.GetSchedule(scheduleID, &GetScheduleOptions{})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	timeZone := "UTC"
+	users := []string{user}
+	shift, resp, err := service.client.OnCallShifts.CreateOnCallShift(&CreateOnCallShiftOptions{
+		TeamId:   schedule.TeamId,
+		Type:     OnCallShiftTypeOverride,
+		Name:     fmt.Sprintf("Override %s %s", user, start.UTC().Format(time.RFC3339)),
+		Start:    start.UTC().Format(onCallShiftTimeLayout),
+		Duration: int(end.Sub(start) / time.Second),
+		Users:    &users,
+		TimeZone: &timeZone,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var shifts []string
+	if schedule.Shifts != nil {
+		shifts = append(shifts, *schedule.Shifts...)
+	}
+	shifts = append(shifts, shift.ID)
+
+	_, resp, err = service.UpdateSchedule(scheduleID, scheduleShiftsUpdate(schedule, shifts))
+	if err != nil {
+		err = fmt.Errorf("failed to attach override %s to schedule %s: %w", shift.ID, scheduleID, err)
+		if _, deleteErr := service.client.OnCallShifts.DeleteOnCallShift(shift.ID, &DeleteOnCallShiftOptions{}); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to delete override %s: %w", shift.ID, deleteErr))
+		}
+		return nil, resp, err
+	}
+
+	return shift, resp, nil
+}
+
+// ListOverrides fetches override shifts of the schedule overlapping the window between from and to.
+// Zero from or to leaves the window open on that side.
+func (service *ScheduleService) ListOverrides(scheduleID string, from, to time.Time) ([]*OnCallShift, *http.Response, error) {
+	opt := &ListOnCallShiftOptions{ScheduleId: scheduleID}
+
+	var overrides []*OnCallShift
+	resp, err := forEachPage(&opt.ListOptions, func() (*PaginatedResponse, *http.Response, error) {
+		page, resp, err := service.client.OnCallShifts.ListOnCallShifts(opt)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, shift := range page.OnCallShifts {
+			if shift.Type != OnCallShiftTypeOverride {
+				continue
+			}
+			start, end, err := shiftPeriod(shift)
+			if err != nil {
+				return nil, resp, err
+			}
+			if (from.IsZero() || end.After(from)) && (to.IsZero() || start.Before(to)) {
+				overrides = append(overrides, shift)
+			}
+		}
+		return &page.PaginatedResponse, resp, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return overrides, resp, nil
+}
+
+// DeleteOverride detaches the override shift from the schedule and deletes it.
+// It refuses to delete shifts which are not overrides.
+func (service *ScheduleService) DeleteOverride(scheduleID string, shiftID string) (*http.Response, error) {
+	shift, resp, err := service.client.OnCallShifts.GetOnCallShift(shiftID, &GetOnCallShiftOptions{})
+	if err != nil {
+		return resp, err
+	}
+	if shift.Type != OnCallShiftTypeOverride {
+		return resp, fmt.Errorf("on-call shift %s is of type %q, not an override", shiftID, shift.Type)
+	}
+
+	schedule, resp, err := service.GetSchedule(scheduleID, &GetScheduleOptions{})
+	if err != nil {
+		return resp, err
+	}
+
+	if schedule.Shifts != nil {
+		var shifts []string
+		for _, id := range *schedule.Shifts {
+			if id != shiftID {
+				shifts = append(shifts, id)
+			}
+		}
+		if len(shifts) != len(*schedule.Shifts) {
+			_, resp, err = service.UpdateSchedule(scheduleID, scheduleShiftsUpdate(schedule, shifts))
+			if err != nil {
+				return resp, fmt.Errorf("failed to detach override %s from schedule %s: %w", shiftID, scheduleID, err)
+			}
+		}
+	}
+
+	return service.client.OnCallShifts.DeleteOnCallShift(shiftID, &DeleteOnCallShiftOptions{})
+}
+
+// scheduleShiftsUpdate builds options updating schedule shifts while keeping its other fields.
+func scheduleShiftsUpdate(schedule *Schedule, shifts []string) *UpdateScheduleOptions {
+	if shifts == nil {
+		shifts = []string{}
+	}
+	return &UpdateScheduleOptions{
+		Name:               schedule.Name,
+		TeamId:             schedule.TeamId,
+		ICalUrlPrimary:     schedule.ICalUrlPrimary,
+		ICalUrlOverrides:   schedule.ICalUrlOverrides,
+		TimeZone:           schedule.TimeZone,
+		EnableWebOverrides: schedule.EnableWebOverrides,
+		Slack:              schedule.Slack,
+		Shifts:             &shifts,
+	}
+}
+
+// shiftPeriod returns start and end of a single-occurrence shift.
+func shiftPeriod(shift *OnCallShift) (time.Time, time.Time, error) {
+	location := time.UTC
+	if shift.TimeZone != nil && *shift.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(*shift.TimeZone)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid time zone of on-call shift %s: %w", shift.ID, err)
+		}
+	}
+
+	start, err := time.ParseInLocation(onCallShiftTimeLayout, shift.Start, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start of on-call shift %s: %w", shift.ID, err)
+	}
+
+	return start, start.Add(time.Duration(shift.Duration) * time.Second), nil
+}
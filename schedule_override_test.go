@@ -0,0 +1,189 @@
+package aapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testOverrideScheduleBody = `{
+	"id": "SBM7DV7BKFUYU",
+	"team_id": "T3HRAP3K3IKOP",
+	"type": "calendar",
+	"name": "Primary",
+	"time_zone": "Europe/Amsterdam",
+	"enable_web_overrides": true,
+	"shifts": ["OH3V5FYQEYJ6M"]
+}`
+
+var testOverrideBody = `{
+	"id": "OKTN6Q8XQP34M",
+	"team_id": "T3HRAP3K3IKOP",
+	"type": "override",
+	"name": "Override",
+	"start": "2026-06-11T20:00:00",
+	"duration": 36000,
+	"users": ["U4DNY931HHJS5"],
+	"time_zone": "UTC"
+}`
+
+func TestCreateOverride(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/schedules/SBM7DV7BKFUYU/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			var opt UpdateScheduleOptions
+			if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+				t.Fatal(err)
+			}
+			if opt.Name != "Primary" || opt.TeamId != "T3HRAP3K3IKOP" || opt.TimeZone != "Europe/Amsterdam" || !opt.EnableWebOverrides {
+				t.Errorf("schedule fields not preserved: %+v", opt)
+			}
+			if want := []string{"OH3V5FYQEYJ6M", "OKTN6Q8XQP34M"}; !reflect.DeepEqual(want, *opt.Shifts) {
+				t.Errorf("shifts = %v, want %v", *opt.Shifts, want)
+			}
+		}
+		fmt.Fprint(w, testOverrideScheduleBody)
+	})
+	mux.HandleFunc("/api/v1/on_call_shifts/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		var opt CreateOnCallShiftOptions
+		if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+			t.Fatal(err)
+		}
+		if opt.Type != "override" || opt.Start != "2026-06-11T20:00:00" || opt.Duration != 36000 || opt.TeamId != "T3HRAP3K3IKOP" {
+			t.Errorf("unexpected shift options %+v", opt)
+		}
+		fmt.Fprint(w, testOverrideBody)
+	})
+
+	start := time.Date(2026, 6, 11, 22, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	shift, _, err := client.Schedules.CreateOverride("SBM7DV7BKFUYU", "U4DNY931HHJS5", start, start.Add(10*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shift.ID != "OKTN6Q8XQP34M" {
+		t.Errorf("returned %+v", shift)
+	}
+}
+
+func TestCreateOverrideCleanup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/schedules/SBM7DV7BKFUYU/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"detail": "invalid shifts"}`)
+			return
+		}
+		fmt.Fprint(w, testOverrideScheduleBody)
+	})
+	mux.HandleFunc("/api/v1/on_call_shifts/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testOverrideBody)
+	})
+	deleted := false
+	mux.HandleFunc("/api/v1/on_call_shifts/OKTN6Q8XQP34M/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "DELETE")
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	start := time.Date(2026, 6, 11, 20, 0, 0, 0, time.UTC)
+	if _, _, err := client.Schedules.CreateOverride("SBM7DV7BKFUYU", "U4DNY931HHJS5", start, start.Add(time.Hour)); err == nil {
+		t.Error("expected error")
+	}
+	if !deleted {
+		t.Error("override shift was not deleted")
+	}
+}
+
+func TestListOverrides(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/on_call_shifts/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("schedule_id"); got != "SBM7DV7BKFUYU" {
+			t.Errorf("schedule_id = %q", got)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 3, "next": null, "previous": null, "results": [%s, %s, %s]}`,
+			testOnCallShiftBody,
+			testOverrideBody,
+			`{"id": "OLATER", "type": "override", "start": "2026-06-20T20:00:00", "duration": 3600, "time_zone": "UTC"}`,
+		))
+	})
+
+	from := time.Date(2026, 6, 12, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 6, 13, 0, 0, 0, 0, time.UTC)
+	overrides, _, err := client.Schedules.ListOverrides("SBM7DV7BKFUYU", from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overrides) != 1 || overrides[0].ID != "OKTN6Q8XQP34M" {
+		t.Errorf("returned %+v", overrides)
+	}
+
+	overrides, _, err = client.Schedules.ListOverrides("SBM7DV7BKFUYU", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overrides) != 2 {
+		t.Errorf("returned %d overrides, want 2", len(overrides))
+	}
+}
+
+func TestDeleteOverride(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	var calls []string
+	mux.HandleFunc("/api/v1/schedules/SBM7DV7BKFUYU/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" schedule")
+		if r.Method == "PUT" {
+			var opt UpdateScheduleOptions
+			if err := json.NewDecoder(r.Body).Decode(&opt); err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"OH3V5FYQEYJ6M"}; !reflect.DeepEqual(want, *opt.Shifts) {
+				t.Errorf("shifts = %v, want %v", *opt.Shifts, want)
+			}
+		}
+		fmt.Fprint(w, `{"id": "SBM7DV7BKFUYU", "name": "Primary", "shifts": ["OH3V5FYQEYJ6M", "OKTN6Q8XQP34M"]}`)
+	})
+	mux.HandleFunc("/api/v1/on_call_shifts/OKTN6Q8XQP34M/", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" shift")
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, testOverrideBody)
+	})
+
+	if _, err := client.Schedules.DeleteOverride("SBM7DV7BKFUYU", "OKTN6Q8XQP34M"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"GET shift", "GET schedule", "PUT schedule", "DELETE shift"}
+	if !reflect.DeepEqual(want, calls) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestDeleteOverrideRefusesRegularShift(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/on_call_shifts/OH3V5FYQEYJ6M/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, testOnCallShiftBody)
+	})
+
+	if _, err := client.Schedules.DeleteOverride("SBM7DV7BKFUYU", "OH3V5FYQEYJ6M"); err == nil {
+		t.Error("expected error for regular shift")
+	}
+}
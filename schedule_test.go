@@ -139,3 +139,25 @@ func TestGetSchedule(t *testing.T) {
 		t.Errorf("returned\n %+v\n want\n %+v\n", schedule, want)
 	}
 }
+
+func TestGetScheduleByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/schedules/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("name"); got != testSchedule.Name {
+			t.Errorf("name filter is %q, want %q", got, testSchedule.Name)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testScheduleBody))
+	})
+
+	schedule, _, err := client.Schedules.GetByName(testSchedule.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testSchedule, schedule) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", schedule, testSchedule)
+	}
+}
@@ -0,0 +1,130 @@
+package aapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+// Secret is a credential, such as a webhook password. It is printed as [REDACTED]
+// by String, GoString and every fmt verb, but marshalled to JSON with its real value.
+type Secret string
+
+// NewSecret returns a pointer to a Secret holding value, for use in options structs.
+func NewSecret(value string) *Secret {
+	s := Secret(value)
+	return &s
+}
+
+// Value returns the real value of the secret.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return "aapi.Secret(" + strconv.Quote(redacted) + ")"
+}
+
+// Format implements fmt.Formatter so that no verb can print the real value.
+func (s Secret) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'q':
+		io.WriteString(f, strconv.Quote(redacted))
+	case 'v':
+		if f.Flag('#') {
+			io.WriteString(f, s.GoString())
+			return
+		}
+		io.WriteString(f, redacted)
+	default:
+		io.WriteString(f, redacted)
+	}
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+var (
+	sensitiveFieldsMu sync.RWMutex
+	// sensitiveFields are JSON keys whose values are redacted from every debug
+	// dump the client produces: logged bodies, the dry-run journal and cassettes.
+	sensitiveFields = map[string]bool{
+		"authorization":        true,
+		"authorization_header": true,
+		"password":             true,
+		"token":                true,
+	}
+)
+
+// AddSensitiveFields adds JSON keys whose values are redacted from debug dumps,
+// in addition to passwords, tokens and authorization headers.
+func AddSensitiveFields(names ...string) {
+	sensitiveFieldsMu.Lock()
+	defer sensitiveFieldsMu.Unlock()
+	for _, name := range names {
+		sensitiveFields[name] = true
+	}
+}
+
+func isSensitiveField(name string) bool {
+	sensitiveFieldsMu.RLock()
+	defer sensitiveFieldsMu.RUnlock()
+	return sensitiveFields[name]
+}
+
+// redactBody replaces values of sensitive fields in a JSON body, which is then
+// re-encoded with sorted keys. Bodies without sensitive fields and bodies which
+// are not JSON are returned as is.
+func redactBody(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// Keep numbers as sent, float64 would round large IDs.
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return string(body)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return string(body)
+	}
+	if !redactValue(v) {
+		return string(body)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+// redactValue replaces values of sensitive fields in place and reports whether it found any.
+func redactValue(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if field != nil && isSensitiveField(k) {
+				v[k] = redacted
+				found = true
+			} else if redactValue(field) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactValue(item) {
+				found = true
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,91 @@
+package aapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSecretFormatting(t *testing.T) {
+	secret := Secret("hunter2")
+	webhook := struct {
+		Name     string
+		Password Secret
+	}{"test", secret}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		for _, arg := range []interface{}{secret, webhook} {
+			if out := fmt.Sprintf(format, arg); strings.Contains(out, "hunter2") || !strings.Contains(out, redacted) {
+				t.Errorf("%s formatted %T as %s", format, arg, out)
+			}
+		}
+	}
+
+	if secret.String() != redacted || strings.Contains(secret.GoString(), "hunter2") {
+		t.Errorf("Secret is not redacted: %s %s", secret.String(), secret.GoString())
+	}
+}
+
+func TestSecretJSON(t *testing.T) {
+	options := &CreateWebhookOptions{Password: NewSecret("hunter2"), AuthorizationHeader: NewSecret("Bearer hunter2")}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"password":"hunter2"`) || !strings.Contains(string(data), `"authorization_header":"Bearer hunter2"`) {
+		t.Errorf("Expected real values in JSON, got %s", data)
+	}
+
+	var webhook Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		t.Fatal(err)
+	}
+	if webhook.Password.Value() != "hunter2" {
+		t.Errorf("Password is %q after unmarshalling, want hunter2", webhook.Password.Value())
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	sensitiveFieldsMu.RLock()
+	saved := make(map[string]bool, len(sensitiveFields))
+	for name := range sensitiveFields {
+		saved[name] = true
+	}
+	sensitiveFieldsMu.RUnlock()
+	t.Cleanup(func() {
+		sensitiveFieldsMu.Lock()
+		defer sensitiveFieldsMu.Unlock()
+		sensitiveFields = saved
+	})
+
+	AddSensitiveFields("api_key")
+
+	got := redactBody([]byte(`{"name": "test", "api_key": "k", "nested": [{"password": "p", "token": null}]}`))
+	want := `{"api_key":"[REDACTED]","name":"test","nested":[{"password":"[REDACTED]","token":null}]}`
+	if got != want {
+		t.Errorf("Redacted body is %s, want %s", got, want)
+	}
+}
+
+func TestRedactBodyDefaultFields(t *testing.T) {
+	got := redactBody([]byte(`{"api_key": "k", "token": "t"}`))
+	want := `{"api_key":"k","token":"[REDACTED]"}`
+	if got != want {
+		t.Errorf("Redacted body is %s, want %s", got, want)
+	}
+}
+
+func TestRedactBodyKeepsNumbers(t *testing.T) {
+	body := `{"id": 12345678901234567891, "v": 1.0}`
+	if got := redactBody([]byte(body)); got != body {
+		t.Errorf("Body without sensitive fields changed to %s", got)
+	}
+
+	got := redactBody([]byte(`{"id": 12345678901234567891, "v": 1.0, "token": "t"}`))
+	want := `{"id":12345678901234567891,"token":"[REDACTED]","v":1.0}`
+	if got != want {
+		t.Errorf("Redacted body is %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,268 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ShiftSwapService handles requests to shift swap endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/
+type ShiftSwapService struct {
+	client *Client
+	url    string
+}
+
+// NewShiftSwapService creates ShiftSwapService with corresponding url part
+func NewShiftSwapService(client *Client) *ShiftSwapService {
+	shiftSwapService := ShiftSwapService{}
+	shiftSwapService.client = client
+	shiftSwapService.url = "shift_swaps"
+	return &shiftSwapService
+}
+
+// Statuses of shift swap requests.
+const (
+	ShiftSwapStatusOpen    = "open"
+	ShiftSwapStatusTaken   = "taken"
+	ShiftSwapStatusPastDue = "past_due"
+	ShiftSwapStatusDeleted = "deleted"
+)
+
+type PaginatedShiftSwapsResponse struct {
+	PaginatedResponse
+	ShiftSwaps []*ShiftSwap `json:"results"`
+}
+
+// ShiftSwap is a request of the Beneficiary user to have their shifts in Schedule
+// between SwapStart and SwapEnd taken by someone else. Benefactor is the user who took them.
+type ShiftSwap struct {
+	ID          string            `json:"id"`
+	Schedule    string            `json:"schedule"`
+	SwapStart   string            `json:"swap_start"`
+	SwapEnd     string            `json:"swap_end"`
+	Beneficiary string            `json:"beneficiary"`
+	Benefactor  *string           `json:"benefactor"`
+	Status      string            `json:"status"`
+	Description *string           `json:"description"`
+	CreatedAt   string            `json:"created_at"`
+	UpdatedAt   string            `json:"updated_at"`
+	Shifts      []*ShiftSwapShift `json:"shifts"`
+}
+
+// ShiftSwapShift is a single occurrence of an on-call shift covered by a swap request.
+type ShiftSwapShift struct {
+	Start         string                `json:"start"`
+	End           string                `json:"end"`
+	AllDay        bool                  `json:"all_day"`
+	PriorityLevel *int                  `json:"priority_level"`
+	Users         []*ShiftSwapShiftUser `json:"users"`
+	Shift         *ShiftSwapShiftRef    `json:"shift"`
+}
+
+type ShiftSwapShiftUser struct {
+	PK          string `json:"pk"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email"`
+}
+
+// ShiftSwapShiftRef references the OnCallShift a shift occurrence belongs to.
+type ShiftSwapShiftRef struct {
+	PK string `json:"pk"`
+}
+
+type ListShiftSwapOptions struct {
+	ListOptions
+	ScheduleID  string `url:"schedule_id,omitempty" json:"schedule_id,omitempty"`
+	Beneficiary string `url:"beneficiary,omitempty" json:"beneficiary,omitempty"`
+	Benefactor  string `url:"benefactor,omitempty" json:"benefactor,omitempty"`
+	// OpenOnly limits results to swap requests with ShiftSwapStatusOpen status.
+	OpenOnly bool `url:"open_only,omitempty" json:"open_only,omitempty"`
+	// StartingAfter limits results to swap requests starting after given RFC 3339 time.
+	// The API defaults to the current time.
+	StartingAfter string `url:"starting_after,omitempty" json:"starting_after,omitempty"`
+}
+
+// Validate checks if the options are valid
+func (o *ListShiftSwapOptions) Validate() error {
+	if o.StartingAfter != "" {
+		if _, err := time.Parse(time.RFC3339, o.StartingAfter); err != nil {
+			return fmt.Errorf("invalid starting_after time: %v", err)
+		}
+	}
+	return nil
+}
+
+// ListShiftSwaps fetches shift swap requests for current organization.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#list-shift-swap-requests
+func (service *ShiftSwapService) ListShiftSwaps(opt *ListShiftSwapOptions) (*PaginatedShiftSwapsResponse, *http.Response, error) {
+	if opt != nil {
+		if err := opt.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("ShiftSwaps.ListShiftSwaps", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var shiftSwaps *PaginatedShiftSwapsResponse
+	resp, err := service.client.Do(req, &shiftSwaps)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return shiftSwaps, resp, err
+}
+
+type GetShiftSwapOptions struct {
+}
+
+// GetShiftSwap fetches shift swap request by given id.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#get-a-shift-swap-request
+func (service *ShiftSwapService) GetShiftSwap(id string, opt *GetShiftSwapOptions) (*ShiftSwap, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("ShiftSwaps.GetShiftSwap", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shiftSwap := new(ShiftSwap)
+	resp, err := service.client.Do(req, shiftSwap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return shiftSwap, resp, err
+}
+
+type CreateShiftSwapOptions struct {
+	Schedule    string  `json:"schedule"`
+	SwapStart   string  `json:"swap_start"`
+	SwapEnd     string  `json:"swap_end"`
+	Beneficiary string  `json:"beneficiary"`
+	Description *string `json:"description,omitempty"`
+}
+
+// CreateShiftSwap creates shift swap request for shifts of the beneficiary in the schedule.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#create-a-shift-swap-request
+func (service *ShiftSwapService) CreateShiftSwap(opt *CreateShiftSwapOptions) (*ShiftSwap, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("ShiftSwaps.CreateShiftSwap", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shiftSwap := new(ShiftSwap)
+	resp, err := service.client.Do(req, shiftSwap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return shiftSwap, resp, err
+}
+
+type UpdateShiftSwapOptions struct {
+	Schedule    string  `json:"schedule"`
+	SwapStart   string  `json:"swap_start"`
+	SwapEnd     string  `json:"swap_end"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateShiftSwap updates shift swap request period and description.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#update-a-shift-swap-request
+func (service *ShiftSwapService) UpdateShiftSwap(id string, opt *UpdateShiftSwapOptions) (*ShiftSwap, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("ShiftSwaps.UpdateShiftSwap", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shiftSwap := new(ShiftSwap)
+	resp, err := service.client.Do(req, shiftSwap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return shiftSwap, resp, err
+}
+
+type DeleteShiftSwapOptions struct {
+}
+
+// DeleteShiftSwap deletes shift swap request.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#delete-a-shift-swap-request
+func (service *ShiftSwapService) DeleteShiftSwap(id string, opt *DeleteShiftSwapOptions) (*http.Response, error) {
+	u := fmt.Sprintf("%s/%s/", service.url, id)
+
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("ShiftSwaps.DeleteShiftSwap", id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.client.Do(req, nil)
+	return resp, err
+}
+
+type TakeShiftSwapOptions struct {
+	Benefactor string `json:"benefactor"`
+}
+
+// TakeShiftSwap takes shift swap request on behalf of the benefactor user.
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/shift_swaps/#take-a-shift-swap-request
+func (service *ShiftSwapService) TakeShiftSwap(id string, opt *TakeShiftSwapOptions) (*ShiftSwap, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/take/", service.url, id)
+
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("ShiftSwaps.TakeShiftSwap", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shiftSwap := new(ShiftSwap)
+	resp, err := service.client.Do(req, shiftSwap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return shiftSwap, resp, err
+}
+
+// AffectedShifts fetches the on-call shifts with occurrences covered by the shift swap request,
+// in the order they first occur in the swap period.
+func (service *ShiftSwapService) AffectedShifts(id string) ([]*OnCallShift, *http.Response, error) {
+	shiftSwap, resp, err := service.GetShiftSwap(id, &GetShiftSwapOptions{})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var shifts []*OnCallShift
+	seen := make(map[string]bool)
+	for _, occurrence := range shiftSwap.Shifts {
+		if occurrence.Shift == nil || occurrence.Shift.PK == "" || seen[occurrence.Shift.PK] {
+			continue
+		}
+		seen[occurrence.Shift.PK] = true
+
+		var shift *OnCallShift
+		shift, resp, err = service.client.OnCallShifts.GetOnCallShift(occurrence.Shift.PK, &GetOnCallShiftOptions{})
+		if err != nil {
+			return nil, resp, err
+		}
+		shifts = append(shifts, shift)
+	}
+
+	return shifts, resp, nil
+}
@@ -0,0 +1,254 @@
+package aapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testShiftSwapDescription = "Dentist appointment"
+var testShiftSwapPriority = 1
+
+var testShiftSwap = &ShiftSwap{
+	ID:          "SSRG1TDNBMJQ1NC",
+	Schedule:    "SBM7DV7BKFUYU",
+	SwapStart:   "2026-06-11T00:00:00Z",
+	SwapEnd:     "2026-07-19T22:00:00Z",
+	Beneficiary: "UWJWIN8MQ1GYL",
+	Status:      ShiftSwapStatusOpen,
+	Description: &testShiftSwapDescription,
+	CreatedAt:   "2026-05-27T11:04:54.137203Z",
+	UpdatedAt:   "2026-05-27T11:04:54.137203Z",
+	Shifts: []*ShiftSwapShift{
+		{
+			Start:         "2026-06-11T03:00:00Z",
+			End:           "2026-06-11T05:00:00Z",
+			PriorityLevel: &testShiftSwapPriority,
+			Users: []*ShiftSwapShiftUser{
+				{PK: "UWJWIN8MQ1GYL", DisplayName: "alex", Email: "alex@example.com"},
+			},
+			Shift: &ShiftSwapShiftRef{PK: "OH3V5FYQEYJ6M"},
+		},
+		{
+			Start:         "2026-06-18T03:00:00Z",
+			End:           "2026-06-18T05:00:00Z",
+			PriorityLevel: &testShiftSwapPriority,
+			Users: []*ShiftSwapShiftUser{
+				{PK: "UWJWIN8MQ1GYL", DisplayName: "alex", Email: "alex@example.com"},
+			},
+			Shift: &ShiftSwapShiftRef{PK: "OH3V5FYQEYJ6M"},
+		},
+	},
+}
+
+var testShiftSwapShiftBody = `{
+	"start": "%s",
+	"end": "%s",
+	"all_day": false,
+	"priority_level": 1,
+	"users": [{"pk": "UWJWIN8MQ1GYL", "display_name": "alex", "email": "alex@example.com"}],
+	"shift": {"pk": "OH3V5FYQEYJ6M"}
+}`
+
+var testShiftSwapBody = fmt.Sprintf(`{
+	"id": "SSRG1TDNBMJQ1NC",
+	"schedule": "SBM7DV7BKFUYU",
+	"swap_start": "2026-06-11T00:00:00Z",
+	"swap_end": "2026-07-19T22:00:00Z",
+	"beneficiary": "UWJWIN8MQ1GYL",
+	"benefactor": null,
+	"status": "open",
+	"description": "Dentist appointment",
+	"created_at": "2026-05-27T11:04:54.137203Z",
+	"updated_at": "2026-05-27T11:04:54.137203Z",
+	"shifts": [%s, %s]
+}`,
+	fmt.Sprintf(testShiftSwapShiftBody, "2026-06-11T03:00:00Z", "2026-06-11T05:00:00Z"),
+	fmt.Sprintf(testShiftSwapShiftBody, "2026-06-18T03:00:00Z", "2026-06-18T05:00:00Z"),
+)
+
+func TestListShiftSwaps(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		want := "beneficiary=UWJWIN8MQ1GYL&open_only=true&schedule_id=SBM7DV7BKFUYU&starting_after=2026-06-01T00%3A00%3A00Z"
+		if got := r.URL.Query().Encode(); got != want {
+			t.Errorf("query = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testShiftSwapBody))
+	})
+
+	options := &ListShiftSwapOptions{
+		ScheduleID:    "SBM7DV7BKFUYU",
+		Beneficiary:   "UWJWIN8MQ1GYL",
+		OpenOnly:      true,
+		StartingAfter: "2026-06-01T00:00:00Z",
+	}
+
+	shiftSwaps, _, err := client.ShiftSwaps.ListShiftSwaps(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PaginatedShiftSwapsResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count:    1,
+			Next:     nil,
+			Previous: nil,
+		},
+		ShiftSwaps: []*ShiftSwap{
+			testShiftSwap,
+		},
+	}
+	if !reflect.DeepEqual(want, shiftSwaps) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", shiftSwaps, want)
+	}
+}
+
+func TestListShiftSwapsValidation(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	_, _, err := client.ShiftSwaps.ListShiftSwaps(&ListShiftSwapOptions{StartingAfter: "2026-06-01"})
+	if err == nil {
+		t.Error("expected error for invalid starting_after")
+	}
+}
+
+func TestGetShiftSwap(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/SSRG1TDNBMJQ1NC/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, testShiftSwapBody)
+	})
+
+	shiftSwap, _, err := client.ShiftSwaps.GetShiftSwap("SSRG1TDNBMJQ1NC", &GetShiftSwapOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testShiftSwap, shiftSwap) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", shiftSwap, testShiftSwap)
+	}
+}
+
+func TestCreateShiftSwap(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testShiftSwapBody)
+	})
+
+	createOptions := &CreateShiftSwapOptions{
+		Schedule:    "SBM7DV7BKFUYU",
+		SwapStart:   "2026-06-11T00:00:00Z",
+		SwapEnd:     "2026-07-19T22:00:00Z",
+		Beneficiary: "UWJWIN8MQ1GYL",
+		Description: &testShiftSwapDescription,
+	}
+	shiftSwap, _, err := client.ShiftSwaps.CreateShiftSwap(createOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testShiftSwap, shiftSwap) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", shiftSwap, testShiftSwap)
+	}
+}
+
+func TestUpdateShiftSwap(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/SSRG1TDNBMJQ1NC/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "PUT")
+		fmt.Fprint(w, testShiftSwapBody)
+	})
+
+	updateOptions := &UpdateShiftSwapOptions{
+		Schedule:  "SBM7DV7BKFUYU",
+		SwapStart: "2026-06-11T00:00:00Z",
+		SwapEnd:   "2026-07-19T22:00:00Z",
+	}
+	shiftSwap, _, err := client.ShiftSwaps.UpdateShiftSwap("SSRG1TDNBMJQ1NC", updateOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testShiftSwap, shiftSwap) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", shiftSwap, testShiftSwap)
+	}
+}
+
+func TestDeleteShiftSwap(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/SSRG1TDNBMJQ1NC/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "DELETE")
+	})
+
+	_, err := client.ShiftSwaps.DeleteShiftSwap("SSRG1TDNBMJQ1NC", &DeleteShiftSwapOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTakeShiftSwap(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/SSRG1TDNBMJQ1NC/take/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		body, _ := io.ReadAll(r.Body)
+		if got, want := string(body), `{"benefactor":"U4DNY931HHJS5"}`; got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"id": "SSRG1TDNBMJQ1NC", "benefactor": "U4DNY931HHJS5", "status": "taken"}`)
+	})
+
+	shiftSwap, _, err := client.ShiftSwaps.TakeShiftSwap("SSRG1TDNBMJQ1NC", &TakeShiftSwapOptions{Benefactor: "U4DNY931HHJS5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if shiftSwap.Status != ShiftSwapStatusTaken || shiftSwap.Benefactor == nil || *shiftSwap.Benefactor != "U4DNY931HHJS5" {
+		t.Errorf("returned %+v", shiftSwap)
+	}
+}
+
+func TestShiftSwapAffectedShifts(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/shift_swaps/SSRG1TDNBMJQ1NC/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testShiftSwapBody)
+	})
+	shiftRequests := 0
+	mux.HandleFunc("/api/v1/on_call_shifts/OH3V5FYQEYJ6M/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		shiftRequests++
+		fmt.Fprint(w, testOnCallShiftBody)
+	})
+
+	shifts, _, err := client.ShiftSwaps.AffectedShifts("SSRG1TDNBMJQ1NC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*OnCallShift{testOnCallShift}
+	if !reflect.DeepEqual(want, shifts) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", shifts, want)
+	}
+	if shiftRequests != 1 {
+		t.Errorf("fetched the shift %d times, want 1", shiftRequests)
+	}
+}
@@ -42,7 +42,7 @@ type ListSlackChannelOptions struct {
 func (service *SlackChannelService) ListSlackChannels(opt *ListSlackChannelOptions) (*PaginatedSlackChannelsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("SlackChannels.ListSlackChannels", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,3 +55,17 @@ func (service *SlackChannelService) ListSlackChannels(opt *ListSlackChannelOptio
 
 	return slackChannels, resp, err
 }
+
+// GetByName fetches the slack channel with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one slack channel matches.
+func (service *SlackChannelService) GetByName(name string) (*SlackChannel, *http.Response, error) {
+	opt := &ListSlackChannelOptions{ChannelName: name}
+	return findOneByName("slack channel", name, func(page int) ([]*SlackChannel, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListSlackChannels(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.SlackChannels, &result.PaginatedResponse, resp, nil
+	}, func(slackChannel *SlackChannel) string { return slackChannel.Name })
+}
@@ -47,3 +47,25 @@ func TestListSlackChannels(t *testing.T) {
 		t.Errorf("returned\n %+v, \nwant\n %+v", slackChannels, want)
 	}
 }
+
+func TestGetSlackChannelByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/slack_channels/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("channel_name"); got != "general" {
+			t.Errorf("channel_name filter is %q, want general", got)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": null, "previous": null, "results": [{"name": "general-2", "slack_id": "OTHER"}, %s]}`, testSlackChannelBody))
+	})
+
+	slackChannel, _, err := client.SlackChannels.GetByName("general")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testSlackChannel, slackChannel) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", slackChannel, testSlackChannel)
+	}
+}
@@ -40,7 +40,7 @@ type ListTeamOptions struct {
 func (service *TeamService) ListTeams(opt *ListTeamOptions) (*PaginatedTeamsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Teams.ListTeams", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,6 +54,20 @@ func (service *TeamService) ListTeams(opt *ListTeamOptions) (*PaginatedTeamsResp
 	return teams, resp, err
 }
 
+// GetByName fetches the team with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one team matches.
+func (service *TeamService) GetByName(name string) (*Team, *http.Response, error) {
+	opt := &ListTeamOptions{Name: name}
+	return findOneByName("team", name, func(page int) ([]*Team, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListTeams(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.Teams, &result.PaginatedResponse, resp, nil
+	}, func(team *Team) string { return team.Name })
+}
+
 type GetTeamOptions struct {
 }
 
@@ -61,7 +75,7 @@ type GetTeamOptions struct {
 func (service *TeamService) GetTeam(id string, opt *GetTeamOptions) (*Team, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Teams.GetTeam", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,6 +1,7 @@
 package aapi
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -53,3 +54,57 @@ func TestListTeams(t *testing.T) {
 		t.Errorf("returned\n %+v, \nwant\n %+v", teams, want)
 	}
 }
+
+func TestGetTeamByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/teams/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"count": 2, "next": "next", "previous": null, "results": [{"id": "TANOTHER", "name": "test team 2"}]}`)
+		case "2":
+			fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": null, "previous": "previous", "results": [%s]}`, testTeamBody))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	team, _, err := client.Teams.GetByName("test team")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testTeam, team) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", team, testTeam)
+	}
+}
+
+func TestGetTeamByNameNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/teams/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 1, "next": null, "previous": null, "results": [{"id": "TANOTHER", "name": "test team 2"}]}`)
+	})
+
+	_, _, err := client.Teams.GetByName("test team")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGetTeamByNameAmbiguous(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/teams/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 2, "next": null, "previous": null, "results": [%s, %s]}`, testTeamBody, testTeamBody))
+	})
+
+	_, _, err := client.Teams.GetByName("test team")
+	if !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("expected ErrAmbiguous, got %v", err)
+	}
+}
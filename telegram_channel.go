@@ -0,0 +1,75 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// TelegramChannelService handles requests to telegram channel endpoint
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/telegram_channels/
+type TelegramChannelService struct {
+	client *Client
+	url    string
+}
+
+// NewTelegramChannelService creates TelegramChannelService with defined url
+func NewTelegramChannelService(client *Client) *TelegramChannelService {
+	telegramChannelService := TelegramChannelService{}
+	telegramChannelService.client = client
+	telegramChannelService.url = "telegram_channels"
+	return &telegramChannelService
+}
+
+type PaginatedTelegramChannelsResponse struct {
+	PaginatedResponse
+	TelegramChannels []*TelegramChannel `json:"results"`
+}
+
+// TelegramChannel is a Telegram channel connected to the organization.
+// Its ID is what TelegramRoute expects.
+type TelegramChannel struct {
+	ID               string `json:"id"`
+	ChannelName      string `json:"channel_name"`
+	ChannelChatId    string `json:"channel_chat_id"`
+	IsDefaultChannel bool   `json:"is_default_channel"`
+}
+
+type ListTelegramChannelOptions struct {
+	ListOptions
+	ChannelName string `url:"channel_name,omitempty" json:"channel_name,omitempty"`
+}
+
+// ListTelegramChannels gets all telegram channels for authorized organization
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/telegram_channels/#list-telegram-channels
+func (service *TelegramChannelService) ListTelegramChannels(opt *ListTelegramChannelOptions) (*PaginatedTelegramChannelsResponse, *http.Response, error) {
+	u := fmt.Sprintf("%s/", service.url)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("TelegramChannels.ListTelegramChannels", ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var telegramChannels *PaginatedTelegramChannelsResponse
+	resp, err := service.client.Do(req, &telegramChannels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return telegramChannels, resp, err
+}
+
+// GetByName fetches the telegram channel with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one telegram channel matches.
+func (service *TelegramChannelService) GetByName(name string) (*TelegramChannel, *http.Response, error) {
+	opt := &ListTelegramChannelOptions{ChannelName: name}
+	return findOneByName("telegram channel", name, func(page int) ([]*TelegramChannel, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListTelegramChannels(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.TelegramChannels, &result.PaginatedResponse, resp, nil
+	}, func(telegramChannel *TelegramChannel) string { return telegramChannel.ChannelName })
+}
@@ -0,0 +1,53 @@
+package aapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var testTelegramChannel = &TelegramChannel{
+	ID:               "TBTH3E3GMAYB7",
+	ChannelName:      "oncall-alerts",
+	ChannelChatId:    "-1001234567890",
+	IsDefaultChannel: true,
+}
+
+var testTelegramChannelBody = `{
+	"id": "TBTH3E3GMAYB7",
+	"channel_name": "oncall-alerts",
+	"channel_chat_id": "-1001234567890",
+	"is_default_channel": true
+}`
+
+func TestListTelegramChannels(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/telegram_channels/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testTelegramChannelBody))
+	})
+
+	options := &ListTelegramChannelOptions{}
+
+	telegramChannels, _, err := client.TelegramChannels.ListTelegramChannels(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PaginatedTelegramChannelsResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count:    1,
+			Next:     nil,
+			Previous: nil,
+		},
+		TelegramChannels: []*TelegramChannel{
+			testTelegramChannel,
+		},
+	}
+	if !reflect.DeepEqual(want, telegramChannels) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", telegramChannels, want)
+	}
+}
@@ -44,7 +44,7 @@ type ListUserOptions struct {
 func (service *UserService) ListUsers(opt *ListUserOptions) (*PaginatedUsersResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Users.ListUsers", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,7 +67,7 @@ type GetUserOptions struct {
 func (service *UserService) GetUser(id string, opt *GetUserOptions) (*User, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Users.GetUser", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -49,7 +49,7 @@ type ListUserGroupOptions struct {
 func (service *UserGroupService) ListUserGroups(opt *ListUserGroupOptions) (*PaginatedUserGroupsResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("UserGroups.ListUserGroups", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -62,3 +62,22 @@ func (service *UserGroupService) ListUserGroups(opt *ListUserGroupOptions) (*Pag
 
 	return userGroups, resp, err
 }
+
+// GetByName fetches the user group whose slack handle is exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one user group matches.
+func (service *UserGroupService) GetByName(name string) (*UserGroup, *http.Response, error) {
+	opt := &ListUserGroupOptions{SlackHandle: name}
+	return findOneByName("user group", name, func(page int) ([]*UserGroup, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListUserGroups(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.UserGroups, &result.PaginatedResponse, resp, nil
+	}, func(userGroup *UserGroup) string {
+		if userGroup.SlackUserGroup == nil {
+			return ""
+		}
+		return userGroup.SlackUserGroup.Handle
+	})
+}
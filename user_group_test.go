@@ -59,3 +59,25 @@ func TestListUserGroups(t *testing.T) {
 		t.Errorf("returned\n %+v, \nwant\n %+v", userGroups, want)
 	}
 }
+
+func TestGetUserGroupByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/user_groups/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("slack_handle"); got != "test" {
+			t.Errorf("slack_handle filter is %q, want test", got)
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testUserGroupBody))
+	})
+
+	userGroup, _, err := client.UserGroups.GetByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testUserGroup, userGroup) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", userGroup, testUserGroup)
+	}
+}
@@ -2,7 +2,6 @@ package aapi
 
 import (
 	"fmt"
-	"log"
 	"net/http"
 )
 
@@ -44,7 +43,7 @@ type ListUserNotificationRuleOptions struct {
 //
 // https://grafana.com/docs/oncall/latest/oncall-api-reference/personal_notification_rules/#list-personal-notification-rules
 func (service *UserNotificationRuleService) ListUserNotificationRules(opt *ListUserNotificationRuleOptions) (*PaginatedUserNotificationRulesResponse, *http.Response, error) {
-	req, err := service.client.NewRequest("GET", service.url, opt)
+	req, err := service.client.NewRequest("GET", service.url, opt, WithOperation("UserNotificationRules.ListUserNotificationRules", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,7 +66,7 @@ type GetUserNotificationRuleOptions struct {
 func (service *UserNotificationRuleService) GetUserNotificationRule(id string, opt *GetUserNotificationRuleOptions) (*UserNotificationRule, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("UserNotificationRules.GetUserNotificationRule", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -95,7 +94,7 @@ type CreateUserNotificationRuleOptions struct {
 // https://grafana.com/docs/oncall/latest/oncall-api-reference/personal_notification_rules/#post-a-personal-notification-rule
 func (service *UserNotificationRuleService) CreateUserNotificationRule(opt *CreateUserNotificationRuleOptions) (*UserNotificationRule, *http.Response, error) {
 	u := fmt.Sprintf("%s/", service.url)
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("UserNotificationRules.CreateUserNotificationRule", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,7 +102,6 @@ func (service *UserNotificationRuleService) CreateUserNotificationRule(opt *Crea
 	userNotificationRule := new(UserNotificationRule)
 
 	resp, err := service.client.Do(req, userNotificationRule)
-	log.Printf("[DEBUG] request success")
 
 	if err != nil {
 		return nil, resp, err
@@ -125,7 +123,7 @@ type UpdateUserNotificationRuleOptions struct {
 func (service *UserNotificationRuleService) UpdateUserNotificationRule(id string, opt *UpdateUserNotificationRuleOptions) (*UserNotificationRule, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("UserNotificationRules.UpdateUserNotificationRule", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,7 +146,7 @@ type DeleteUserNotificationRuleOptions struct {
 func (service *UserNotificationRuleService) DeleteUserNotificationRule(id string, opt *DeleteUserNotificationRuleOptions) (*http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("UserNotificationRules.DeleteUserNotificationRule", id))
 	if err != nil {
 		return nil, err
 	}
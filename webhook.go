@@ -1,8 +1,11 @@
 package aapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 )
 
 // WebhookService handles requests to outgoing webhook endpoint
@@ -21,6 +24,49 @@ func NewWebhookService(client *Client) *WebhookService {
 	return &WebhookService
 }
 
+// Trigger types of outgoing webhooks, also sent as the event type of their payload.
+// The API may support trigger types not listed here.
+const (
+	WebhookTriggerEscalation        = "escalation"
+	WebhookTriggerAlertGroupCreated = "alert group created"
+	WebhookTriggerAcknowledge       = "acknowledge"
+	WebhookTriggerResolve           = "resolve"
+	WebhookTriggerSilence           = "silence"
+	WebhookTriggerUnsilence         = "unsilence"
+	WebhookTriggerUnresolve         = "unresolve"
+	WebhookTriggerUnacknowledge     = "unacknowledge"
+	WebhookTriggerStatusChange      = "status change"
+)
+
+var webhookTriggerTypes = []string{
+	WebhookTriggerEscalation,
+	WebhookTriggerAlertGroupCreated,
+	WebhookTriggerAcknowledge,
+	WebhookTriggerResolve,
+	WebhookTriggerSilence,
+	WebhookTriggerUnsilence,
+	WebhookTriggerUnresolve,
+	WebhookTriggerUnacknowledge,
+	WebhookTriggerStatusChange,
+}
+
+// HTTP methods supported by outgoing webhooks.
+const (
+	WebhookMethodGet     = "GET"
+	WebhookMethodPost    = "POST"
+	WebhookMethodPut     = "PUT"
+	WebhookMethodDelete  = "DELETE"
+	WebhookMethodOptions = "OPTIONS"
+)
+
+var webhookHttpMethods = []string{
+	WebhookMethodGet,
+	WebhookMethodPost,
+	WebhookMethodPut,
+	WebhookMethodDelete,
+	WebhookMethodOptions,
+}
+
 type PaginatedWebhooksResponse struct {
 	PaginatedResponse
 	Webhooks []*Webhook `json:"results"`
@@ -36,8 +82,8 @@ type Webhook struct {
 	HttpMethod          string    `json:"http_method"`
 	Data                *string   `json:"data"`
 	Username            *string   `json:"username"`
-	Password            *string   `json:"password"`
-	AuthorizationHeader *string   `json:"authorization_header"`
+	Password            *Secret   `json:"password"`
+	AuthorizationHeader *Secret   `json:"authorization_header"`
 	TriggerTemplate     *string   `json:"trigger_template"`
 	Headers             *string   `json:"headers"`
 	ForwardAll          bool      `json:"forward_all"`
@@ -45,6 +91,131 @@ type Webhook struct {
 	IsWebhookEnabled    bool      `json:"is_webhook_enabled"`
 }
 
+// HeaderMap decodes JSON-encoded Headers of the webhook.
+func (w *Webhook) HeaderMap() (map[string]string, error) {
+	return DecodeWebhookHeaders(w.Headers)
+}
+
+// EncodeWebhookHeaders encodes headers into the JSON string expected in webhook Headers.
+// Nil or empty headers are encoded as nil.
+func EncodeWebhookHeaders(headers map[string]string) (*string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(headers)
+	if err != nil {
+		return nil, err
+	}
+	encoded := string(data)
+	return &encoded, nil
+}
+
+// DecodeWebhookHeaders decodes webhook Headers. Nil or empty headers are decoded as empty map.
+func DecodeWebhookHeaders(headers *string) (map[string]string, error) {
+	decoded := map[string]string{}
+	if headers == nil || strings.TrimSpace(*headers) == "" {
+		return decoded, nil
+	}
+	if err := json.Unmarshal([]byte(*headers), &decoded); err != nil {
+		return nil, fmt.Errorf("invalid webhook headers: %v", err)
+	}
+	return decoded, nil
+}
+
+// validateWebhook checks trigger type and http method against the values known to this client,
+// and the data template, shared by create and update options.
+func validateWebhook(triggerType, httpMethod string, data *string) error {
+	if triggerType != "" && !containsString(webhookTriggerTypes, triggerType) {
+		return fmt.Errorf("invalid webhook trigger type %q. Expected one of: %s", triggerType, strings.Join(webhookTriggerTypes, ", "))
+	}
+	if httpMethod != "" && !containsString(webhookHttpMethods, httpMethod) {
+		return fmt.Errorf("invalid webhook http method %q. Expected one of: %s", httpMethod, strings.Join(webhookHttpMethods, ", "))
+	}
+	return validateWebhookData(data)
+}
+
+func validateWebhookData(data *string) error {
+	if data != nil {
+		if err := ValidateWebhookTemplate(*data); err != nil {
+			return fmt.Errorf("invalid webhook data: %v", err)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+var endRawPattern = regexp.MustCompile(`\{%[-+]?\s*endraw\s*[-+]?%\}`)
+
+// ValidateWebhookTemplate checks that a Jinja2 template, such as webhook Data,
+// has all {{ }}, {% %} and {# #} delimiters closed and all block tags
+// (if, for, macro, ...) matched by their end tags.
+func ValidateWebhookTemplate(template string) error {
+	closers := map[string]string{"{{": "}}", "{%": "%}", "{#": "#}"}
+	var blocks []string
+
+	for rest := template; ; {
+		start := strings.Index(rest, "{")
+		if start < 0 || start == len(rest)-1 {
+			break
+		}
+		closer, ok := closers[rest[start:start+2]]
+		if !ok {
+			rest = rest[start+1:]
+			continue
+		}
+		end := strings.Index(rest[start+2:], closer)
+		if end < 0 {
+			return fmt.Errorf("unclosed %q at offset %d", rest[start:start+2], len(template)-len(rest)+start)
+		}
+		tag := strings.Trim(rest[start+2:start+2+end], "-+ \t\n")
+		rest = rest[start+2+end+2:]
+
+		if closer != "%}" {
+			continue
+		}
+		fields := strings.Fields(tag)
+		if len(fields) == 0 {
+			return fmt.Errorf("empty {%% %%} tag")
+		}
+		name := fields[0]
+		switch {
+		case name == "raw":
+			// Raw content is not parsed, skip to the end tag.
+			loc := endRawPattern.FindStringIndex(rest)
+			if loc == nil {
+				return fmt.Errorf("unclosed {%% raw %%}")
+			}
+			rest = rest[loc[1]:]
+		case name == "set" && strings.Contains(tag, "="):
+			// Inline assignment, not a block.
+		case containsString([]string{"if", "for", "macro", "call", "filter", "set", "with", "block"}, name):
+			blocks = append(blocks, name)
+		case name == "elif" || name == "else":
+			if len(blocks) == 0 || (blocks[len(blocks)-1] != "if" && blocks[len(blocks)-1] != "for") {
+				return fmt.Errorf("unexpected {%% %s %%}", name)
+			}
+		case strings.HasPrefix(name, "end"):
+			if len(blocks) == 0 || blocks[len(blocks)-1] != strings.TrimPrefix(name, "end") {
+				return fmt.Errorf("unexpected {%% %s %%}", name)
+			}
+			blocks = blocks[:len(blocks)-1]
+		}
+	}
+
+	if len(blocks) > 0 {
+		return fmt.Errorf("unclosed {%% %s %%}", blocks[len(blocks)-1])
+	}
+	return nil
+}
+
 type ListWebhookOptions struct {
 	ListOptions
 	Name string `url:"name,omitempty" json:"name,omitempty"`
@@ -56,7 +227,7 @@ type ListWebhookOptions struct {
 func (service *WebhookService) ListWebhooks(opt *ListWebhookOptions) (*PaginatedWebhooksResponse, *http.Response, error) {
 	u := fmt.Sprintf("%s", service.url)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Webhooks.ListWebhooks", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,6 +241,67 @@ func (service *WebhookService) ListWebhooks(opt *ListWebhookOptions) (*Paginated
 	return Webhooks, resp, err
 }
 
+type PaginatedWebhookResponsesResponse struct {
+	PaginatedResponse
+	WebhookResponses []*WebhookResponse `json:"results"`
+}
+
+// WebhookResponse is a record of a single outgoing webhook call.
+type WebhookResponse struct {
+	Timestamp      string  `json:"timestamp"`
+	Url            string  `json:"url"`
+	RequestTrigger string  `json:"request_trigger"`
+	RequestHeaders *string `json:"request_headers"`
+	RequestData    *string `json:"request_data"`
+	StatusCode     *int    `json:"status_code"`
+	Content        *string `json:"content"`
+	EventData      *string `json:"event_data"`
+}
+
+// Failed reports whether the webhook call got no response or a non-2xx status code.
+func (r *WebhookResponse) Failed() bool {
+	return r.StatusCode == nil || *r.StatusCode < 200 || *r.StatusCode >= 300
+}
+
+type ListWebhookResponsesOptions struct {
+	ListOptions
+	AlertGroupID string `url:"alert_group_id,omitempty" json:"alert_group_id,omitempty"`
+}
+
+// ListWebhookResponses fetches the history of calls of the webhook, most recent first
+//
+// https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/outgoing_webhooks/#get-webhook-responses
+func (service *WebhookService) ListWebhookResponses(id string, opt *ListWebhookResponsesOptions) (*PaginatedWebhookResponsesResponse, *http.Response, error) {
+	u := fmt.Sprintf("%s/%s/responses/", service.url, id)
+
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Webhooks.ListWebhookResponses", id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var WebhookResponses *PaginatedWebhookResponsesResponse
+	resp, err := service.client.Do(req, &WebhookResponses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return WebhookResponses, resp, err
+}
+
+// GetByName fetches the webhook with exactly the given name.
+// It pages through all results and returns ErrNotFound or ErrAmbiguous unless exactly one webhook matches.
+func (service *WebhookService) GetByName(name string) (*Webhook, *http.Response, error) {
+	opt := &ListWebhookOptions{Name: name}
+	return findOneByName("webhook", name, func(page int) ([]*Webhook, *PaginatedResponse, *http.Response, error) {
+		opt.Page = page
+		result, resp, err := service.ListWebhooks(opt)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		return result.Webhooks, &result.PaginatedResponse, resp, nil
+	}, func(webhook *Webhook) string { return webhook.Name })
+}
+
 type GetWebhookOptions struct {
 }
 
@@ -79,7 +311,7 @@ type GetWebhookOptions struct {
 func (service *WebhookService) GetWebhook(id string, opt *GetWebhookOptions) (*Webhook, *http.Response, error) {
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("GET", u, opt)
+	req, err := service.client.NewRequest("GET", u, opt, WithOperation("Webhooks.GetWebhook", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,8 +334,8 @@ type CreateWebhookOptions struct {
 	HttpMethod          string    `json:"http_method"`
 	Data                *string   `json:"data"`
 	Username            *string   `json:"username"`
-	Password            *string   `json:"password"`
-	AuthorizationHeader *string   `json:"authorization_header"`
+	Password            *Secret   `json:"password"`
+	AuthorizationHeader *Secret   `json:"authorization_header"`
 	TriggerTemplate     *string   `json:"trigger_template"`
 	Headers             *string   `json:"headers"`
 	ForwardAll          bool      `json:"forward_all"`
@@ -111,13 +343,35 @@ type CreateWebhookOptions struct {
 	IsWebhookEnabled    bool      `json:"is_webhook_enabled"`
 }
 
+// SetHeaders JSON-encodes headers into Headers.
+func (o *CreateWebhookOptions) SetHeaders(headers map[string]string) error {
+	encoded, err := EncodeWebhookHeaders(headers)
+	if err != nil {
+		return err
+	}
+	o.Headers = encoded
+	return nil
+}
+
+// Validate checks if the options are valid, including that trigger type and http method
+// are among the values known to this client. CreateWebhook only checks the data template.
+func (o *CreateWebhookOptions) Validate() error {
+	return validateWebhook(o.TriggerType, o.HttpMethod, o.Data)
+}
+
 // CreateWebhook creates webhook
 //
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/outgoing_webhooks/
 func (service *WebhookService) CreateWebhook(opt *CreateWebhookOptions) (*Webhook, *http.Response, error) {
+	if opt != nil {
+		if err := validateWebhookData(opt.Data); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := fmt.Sprintf("%s/", service.url)
 
-	req, err := service.client.NewRequest("POST", u, opt)
+	req, err := service.client.NewRequest("POST", u, opt, WithOperation("Webhooks.CreateWebhook", ""))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -142,8 +396,8 @@ type UpdateWebhookOptions struct {
 	HttpMethod          string    `json:"http_method"`
 	Data                *string   `json:"data"`
 	Username            *string   `json:"username"`
-	Password            *string   `json:"password"`
-	AuthorizationHeader *string   `json:"authorization_header"`
+	Password            *Secret   `json:"password"`
+	AuthorizationHeader *Secret   `json:"authorization_header"`
 	TriggerTemplate     *string   `json:"trigger_template"`
 	Headers             *string   `json:"headers"`
 	ForwardAll          bool      `json:"forward_all"`
@@ -151,13 +405,35 @@ type UpdateWebhookOptions struct {
 	IsWebhookEnabled    bool      `json:"is_webhook_enabled"`
 }
 
+// SetHeaders JSON-encodes headers into Headers.
+func (o *UpdateWebhookOptions) SetHeaders(headers map[string]string) error {
+	encoded, err := EncodeWebhookHeaders(headers)
+	if err != nil {
+		return err
+	}
+	o.Headers = encoded
+	return nil
+}
+
+// Validate checks if the options are valid, including that trigger type and http method
+// are among the values known to this client. UpdateWebhook only checks the data template.
+func (o *UpdateWebhookOptions) Validate() error {
+	return validateWebhook(o.TriggerType, o.HttpMethod, o.Data)
+}
+
 // UpdateWebhook updates webhook
 //
 // https://grafana.com/docs/grafana-cloud/oncall/oncall-api-reference/outgoing_webhooks/
 func (service *WebhookService) UpdateWebhook(id string, opt *UpdateWebhookOptions) (*Webhook, *http.Response, error) {
+	if opt != nil {
+		if err := validateWebhookData(opt.Data); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("PUT", u, opt)
+	req, err := service.client.NewRequest("PUT", u, opt, WithOperation("Webhooks.UpdateWebhook", id))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -181,7 +457,7 @@ func (service *WebhookService) DeleteWebhook(id string, opt *DeleteWebhookOption
 
 	u := fmt.Sprintf("%s/%s/", service.url, id)
 
-	req, err := service.client.NewRequest("DELETE", u, opt)
+	req, err := service.client.NewRequest("DELETE", u, opt, WithOperation("Webhooks.DeleteWebhook", id))
 	if err != nil {
 		return nil, err
 	}
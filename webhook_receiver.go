@@ -0,0 +1,168 @@
+package aapi
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// maxWebhookEventBytes limits the size of webhook payloads accepted by WebhookReceiver.
+const maxWebhookEventBytes = 10 << 20
+
+// WebhookEvent is the payload OnCall sends with outgoing webhooks.
+// Event.Type is one of the WebhookTrigger* trigger types.
+//
+// https://grafana.com/docs/oncall/latest/configure/integrations/outgoing-webhooks/#outgoing-webhook-templates
+type WebhookEvent struct {
+	Event                    WebhookEventInfo    `json:"event"`
+	User                     *WebhookEventUser   `json:"user"`
+	AlertGroup               *WebhookAlertGroup  `json:"alert_group"`
+	AlertGroupID             string              `json:"alert_group_id"`
+	AlertPayload             json.RawMessage     `json:"alert_payload"`
+	AlertGroupAcknowledgedBy *WebhookEventUser   `json:"alert_group_acknowledged_by"`
+	AlertGroupResolvedBy     *WebhookEventUser   `json:"alert_group_resolved_by"`
+	Integration              *WebhookIntegration `json:"integration"`
+	NotifiedUsers            []*WebhookEventUser `json:"notified_users"`
+	UsersToBeNotified        []*WebhookEventUser `json:"users_to_be_notified"`
+	// Responses holds the last response of other webhooks of the alert group, by webhook ID.
+	Responses map[string]json.RawMessage `json:"responses"`
+}
+
+type WebhookEventInfo struct {
+	Type string `json:"type"`
+	Time string `json:"time"`
+}
+
+type WebhookEventUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+type WebhookAlertGroup struct {
+	ID             string            `json:"id"`
+	IntegrationID  string            `json:"integration_id"`
+	RouteID        string            `json:"route_id"`
+	AlertsCount    int               `json:"alerts_count"`
+	State          string            `json:"state"`
+	CreatedAt      string            `json:"created_at"`
+	ResolvedAt     *string           `json:"resolved_at"`
+	AcknowledgedAt *string           `json:"acknowledged_at"`
+	Title          string            `json:"title"`
+	Permalinks     map[string]string `json:"permalinks"`
+	Labels         map[string]string `json:"labels"`
+}
+
+type WebhookIntegration struct {
+	ID   string  `json:"id"`
+	Type string  `json:"type"`
+	Name string  `json:"name"`
+	Team *string `json:"team"`
+}
+
+// WebhookEventHandler handles a received outgoing webhook event.
+// A returned error makes the receiver answer with 500 Internal Server Error,
+// which OnCall records as the webhook response.
+type WebhookEventHandler func(r *http.Request, event *WebhookEvent) error
+
+// WebhookReceiver is an http.Handler accepting OnCall outgoing webhooks,
+// decoding their payload and dispatching it to handlers registered by event type.
+//
+//	receiver := aapi.NewWebhookReceiver(aapi.NewSecret(os.Getenv("ONCALL_WEBHOOK_AUTH")))
+//	receiver.On(aapi.WebhookTriggerResolve, func(r *http.Request, event *aapi.WebhookEvent) error {
+//		return closeTicket(event.AlertGroupID)
+//	})
+//	http.Handle("/oncall", receiver)
+type WebhookReceiver struct {
+	// OnError, when set, is called with every error returned by a handler.
+	// The error is not sent back, as OnCall stores the response in the webhook history.
+	OnError func(err error)
+
+	authorization *Secret
+
+	mu       sync.RWMutex
+	handlers map[string][]WebhookEventHandler
+	fallback []WebhookEventHandler
+}
+
+// NewWebhookReceiver creates WebhookReceiver. When authorization is not nil, requests
+// must carry it in the Authorization header, matching the webhook AuthorizationHeader.
+func NewWebhookReceiver(authorization *Secret) *WebhookReceiver {
+	return &WebhookReceiver{
+		authorization: authorization,
+		handlers:      make(map[string][]WebhookEventHandler),
+	}
+}
+
+// On registers handler for events of given type, one of the WebhookTrigger* trigger types. Handlers run in the order they were registered.
+func (wr *WebhookReceiver) On(eventType string, handler WebhookEventHandler) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	wr.handlers[eventType] = append(wr.handlers[eventType], handler)
+}
+
+// OnAny registers handler for events of every type, run after type-specific handlers.
+func (wr *WebhookReceiver) OnAny(handler WebhookEventHandler) {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	wr.fallback = append(wr.fallback, handler)
+}
+
+func (wr *WebhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if wr.authorization != nil {
+		got := r.Header.Get("Authorization")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(wr.authorization.Value())) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxWebhookEventBytes)
+	event, err := DecodeWebhookEvent(r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	wr.mu.RLock()
+	handlers := append(append([]WebhookEventHandler{}, wr.handlers[event.Event.Type]...), wr.fallback...)
+	wr.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(r, event); err != nil {
+			if wr.OnError != nil {
+				wr.OnError(fmt.Errorf("%s event of alert group %s: %w", event.Event.Type, event.AlertGroupID, err))
+			}
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DecodeWebhookEvent decodes the outgoing webhook payload from the request body.
+func DecodeWebhookEvent(r *http.Request) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if event.Event.Type == "" {
+		return nil, fmt.Errorf("invalid webhook payload: missing event type")
+	}
+	return &event, nil
+}
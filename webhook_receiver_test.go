@@ -0,0 +1,139 @@
+package aapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testWebhookEventBody = `{
+	"event": {"type": "resolve", "time": "2023-04-19T21:59:21.714058+00:00"},
+	"user": {"id": "UVMX6YI9VY9PV", "username": "admin", "email": "admin@localhost"},
+	"alert_group": {
+		"id": "I6HNZGUFG4K11",
+		"integration_id": "CZ7URAT4V3QF2",
+		"route_id": "RKHXJKVZYYVST",
+		"alerts_count": 1,
+		"state": "resolved",
+		"created_at": "2023-04-19T21:53:48.231148Z",
+		"resolved_at": "2023-04-19T21:59:21.714058Z",
+		"acknowledged_at": null,
+		"title": "Incident",
+		"permalinks": {"web": "http://localhost:8080/a/grafana-oncall-app/alert-groups/I6HNZGUFG4K11"},
+		"labels": {"severity": "critical"}
+	},
+	"alert_group_id": "I6HNZGUFG4K11",
+	"alert_payload": {"message": "This alert was sent by user for demonstration purposes"},
+	"alert_group_acknowledged_by": null,
+	"alert_group_resolved_by": {"id": "UVMX6YI9VY9PV", "username": "admin", "email": "admin@localhost"},
+	"integration": {"id": "CZ7URAT4V3QF2", "type": "webhook", "name": "Main", "team": null},
+	"notified_users": [],
+	"users_to_be_notified": [],
+	"responses": {"WHAXK4BTC7TAEQ": {"id": "third-party-id"}}
+}`
+
+func TestWebhookReceiver(t *testing.T) {
+	receiver := NewWebhookReceiver(NewSecret("Bearer secret"))
+
+	var resolved, all []*WebhookEvent
+	receiver.On(WebhookTriggerResolve, func(r *http.Request, event *WebhookEvent) error {
+		resolved = append(resolved, event)
+		return nil
+	})
+	receiver.On(WebhookTriggerAcknowledge, func(r *http.Request, event *WebhookEvent) error {
+		t.Error("unexpected acknowledge handler call")
+		return nil
+	})
+	receiver.OnAny(func(r *http.Request, event *WebhookEvent) error {
+		all = append(all, event)
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "/oncall", strings.NewReader(testWebhookEventBody))
+	req.Header.Set("Authorization", "Bearer secret")
+	w := httptest.NewRecorder()
+	receiver.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if len(resolved) != 1 || len(all) != 1 {
+		t.Fatalf("got %d resolve and %d fallback calls, want 1 and 1", len(resolved), len(all))
+	}
+
+	event := resolved[0]
+	resolvedAt := "2023-04-19T21:59:21.714058Z"
+	wantAlertGroup := &WebhookAlertGroup{
+		ID:            "I6HNZGUFG4K11",
+		IntegrationID: "CZ7URAT4V3QF2",
+		RouteID:       "RKHXJKVZYYVST",
+		AlertsCount:   1,
+		State:         "resolved",
+		CreatedAt:     "2023-04-19T21:53:48.231148Z",
+		ResolvedAt:    &resolvedAt,
+		Title:         "Incident",
+		Permalinks:    map[string]string{"web": "http://localhost:8080/a/grafana-oncall-app/alert-groups/I6HNZGUFG4K11"},
+		Labels:        map[string]string{"severity": "critical"},
+	}
+	if !reflect.DeepEqual(wantAlertGroup, event.AlertGroup) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", event.AlertGroup, wantAlertGroup)
+	}
+	wantUser := &WebhookEventUser{ID: "UVMX6YI9VY9PV", Username: "admin", Email: "admin@localhost"}
+	if !reflect.DeepEqual(wantUser, event.AlertGroupResolvedBy) || event.AlertGroupAcknowledgedBy != nil {
+		t.Errorf("unexpected resolved_by %+v or acknowledged_by %+v", event.AlertGroupResolvedBy, event.AlertGroupAcknowledgedBy)
+	}
+	wantIntegration := &WebhookIntegration{ID: "CZ7URAT4V3QF2", Type: "webhook", Name: "Main"}
+	if !reflect.DeepEqual(wantIntegration, event.Integration) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", event.Integration, wantIntegration)
+	}
+	if got := string(event.Responses["WHAXK4BTC7TAEQ"]); got != `{"id": "third-party-id"}` {
+		t.Errorf("responses = %s", got)
+	}
+}
+
+func TestWebhookReceiverErrors(t *testing.T) {
+	receiver := NewWebhookReceiver(NewSecret("Bearer secret"))
+	receiver.On(WebhookTriggerResolve, func(r *http.Request, event *WebhookEvent) error {
+		return errors.New("ticket system unavailable")
+	})
+	var handlerErrs []error
+	receiver.OnError = func(err error) {
+		handlerErrs = append(handlerErrs, err)
+	}
+
+	tests := []struct {
+		method        string
+		authorization string
+		body          string
+		want          int
+	}{
+		{"GET", "Bearer secret", "", http.StatusMethodNotAllowed},
+		{"POST", "Bearer wrong", testWebhookEventBody, http.StatusUnauthorized},
+		{"POST", "", testWebhookEventBody, http.StatusUnauthorized},
+		{"POST", "Bearer secret", `{"event": {}}`, http.StatusBadRequest},
+		{"POST", "Bearer secret", `not json`, http.StatusBadRequest},
+		{"POST", "Bearer secret", testWebhookEventBody, http.StatusInternalServerError},
+		{"POST", "Bearer secret", `{"event": {"type": "resolve"}, "alert_payload": "` + strings.Repeat("x", maxWebhookEventBytes) + `"}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, "/oncall", strings.NewReader(tt.body))
+		if tt.authorization != "" {
+			req.Header.Set("Authorization", tt.authorization)
+		}
+		w := httptest.NewRecorder()
+		receiver.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s with %q: status = %d, want %d", tt.method, tt.authorization, w.Code, tt.want)
+		}
+		if strings.Contains(w.Body.String(), "ticket system") {
+			t.Errorf("handler error leaked in response body %q", w.Body.String())
+		}
+	}
+
+	if len(handlerErrs) != 1 || !strings.Contains(handlerErrs[0].Error(), "ticket system unavailable") {
+		t.Errorf("OnError got %v, want the handler error", handlerErrs)
+	}
+}
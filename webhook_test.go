@@ -12,7 +12,7 @@ var testWebhook = &Webhook{
 	Name:        "Test action",
 	Team:        "T3HRAP3K3IKOP",
 	HttpMethod:  "POST",
-	TriggerType: "escalation step",
+	TriggerType: "escalation",
 	Url:         "http://test.com",
 }
 
@@ -21,7 +21,7 @@ var testWebhookBody = `{
 	"name": "Test action",
 	"team": "T3HRAP3K3IKOP",
 	"http_method": "POST",
-	"trigger_type": "escalation step",
+	"trigger_type": "escalation",
 	"url":"http://test.com"
 }`
 
@@ -123,3 +123,188 @@ func TestGetWebhook(t *testing.T) {
 		t.Errorf("returned\n %+v\n want\n %+v\n", Webhook, want)
 	}
 }
+
+func TestWebhookHeaders(t *testing.T) {
+	headers := map[string]string{"X-Team": "sre", "Content-Type": "application/json"}
+
+	opt := &CreateWebhookOptions{}
+	if err := opt.SetHeaders(headers); err != nil {
+		t.Fatal(err)
+	}
+
+	webhook := &Webhook{Headers: opt.Headers}
+	got, err := webhook.HeaderMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(headers, got) {
+		t.Errorf("returned\n %+v\n want\n %+v\n", got, headers)
+	}
+
+	if err := opt.SetHeaders(nil); err != nil || opt.Headers != nil {
+		t.Errorf("expected nil headers, got %v, %v", opt.Headers, err)
+	}
+
+	invalid := "not json"
+	if _, err := (&Webhook{Headers: &invalid}).HeaderMap(); err == nil {
+		t.Error("expected error for invalid headers")
+	}
+}
+
+func TestValidateWebhookTemplate(t *testing.T) {
+	valid := []string{
+		``,
+		`{"alert": "{{ alert_payload.title }}"}`,
+		`{% if alert_group.state == "firing" %}{"a": {"b": 1}}{% else %}{}{% endif %}`,
+		`{%- for u in users -%}{{ u }}{# comment #}{% endfor %}`,
+		`{% set x = 1 %}{{ x }}`,
+		`{% raw %}{{ {% endraw %}`,
+		`{%- raw -%}{% if x %}{#{%- endraw %}{{ x }}`,
+	}
+	for _, template := range valid {
+		if err := ValidateWebhookTemplate(template); err != nil {
+			t.Errorf("%q: unexpected error %v", template, err)
+		}
+	}
+
+	invalid := []string{
+		`{"alert": "{{ alert_payload.title }"}`,
+		`{% if true %}x`,
+		`{% for u in users %}{% endif %}`,
+		`{% endfor %}`,
+		`{% else %}`,
+		`{# comment`,
+		`{% raw %}{{ x }}`,
+		`{% raw %}{% endraw %}{% endraw %}`,
+	}
+	for _, template := range invalid {
+		if err := ValidateWebhookTemplate(template); err == nil {
+			t.Errorf("%q: expected error", template)
+		}
+	}
+}
+
+func TestCreateWebhookValidation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "POST")
+		fmt.Fprint(w, testWebhookBody)
+	})
+
+	data := `{"title": "{{ alert_payload.title }"}`
+	if _, _, err := client.Webhooks.CreateWebhook(&CreateWebhookOptions{Name: "Test Webhook", Url: "https://example.com", Data: &data}); err == nil {
+		t.Error("expected error for invalid data template")
+	}
+
+	// Trigger types and methods unknown to the client are left for the API to validate.
+	options := &CreateWebhookOptions{Name: "Test Webhook", Url: "https://example.com", TriggerType: "personal notification", HttpMethod: "PATCH"}
+	if _, _, err := client.Webhooks.CreateWebhook(options); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := options.Validate(); err == nil {
+		t.Error("expected Validate to reject unknown trigger type")
+	}
+
+	valid := &CreateWebhookOptions{Name: "Test Webhook", Url: "https://example.com", TriggerType: WebhookTriggerAcknowledge, HttpMethod: WebhookMethodPost}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestListWebhookResponses(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/webhooks/KGEFG74LU1D8L/responses/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("alert_group_id"); got != "I68T24C13IFW1" {
+			t.Errorf("alert_group_id = %q, want I68T24C13IFW1", got)
+		}
+		fmt.Fprint(w, `{"count": 2, "next": null, "previous": null, "results": [
+			{
+				"timestamp": "2023-08-18T16:38:23.106007Z",
+				"url": "https://example.com",
+				"request_trigger": "",
+				"request_headers": "{\"Content-Type\": \"application/json\"}",
+				"request_data": "{\"alert_group_id\": \"I68T24C13IFW1\"}",
+				"status_code": 200,
+				"content": "{\"id\": \"third-party-id\"}",
+				"event_data": "{\"type\": \"resolve\"}"
+			},
+			{
+				"timestamp": "2023-08-18T16:30:01.000000Z",
+				"url": "https://example.com",
+				"request_trigger": "",
+				"request_headers": null,
+				"request_data": null,
+				"status_code": null,
+				"content": null,
+				"event_data": "{\"type\": \"escalation\"}"
+			}
+		]}`)
+	})
+
+	responses, _, err := client.Webhooks.ListWebhookResponses("KGEFG74LU1D8L", &ListWebhookResponsesOptions{AlertGroupID: "I68T24C13IFW1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statusCode := 200
+	requestHeaders := `{"Content-Type": "application/json"}`
+	requestData := `{"alert_group_id": "I68T24C13IFW1"}`
+	content := `{"id": "third-party-id"}`
+	resolveEvent := `{"type": "resolve"}`
+	escalationEvent := `{"type": "escalation"}`
+	want := &PaginatedWebhookResponsesResponse{
+		PaginatedResponse: PaginatedResponse{
+			Count: 2,
+		},
+		WebhookResponses: []*WebhookResponse{
+			{
+				Timestamp:      "2023-08-18T16:38:23.106007Z",
+				Url:            "https://example.com",
+				RequestHeaders: &requestHeaders,
+				RequestData:    &requestData,
+				StatusCode:     &statusCode,
+				Content:        &content,
+				EventData:      &resolveEvent,
+			},
+			{
+				Timestamp: "2023-08-18T16:30:01.000000Z",
+				Url:       "https://example.com",
+				EventData: &escalationEvent,
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, responses) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", responses, want)
+	}
+
+	if responses.WebhookResponses[0].Failed() || !responses.WebhookResponses[1].Failed() {
+		t.Error("unexpected Failed result")
+	}
+}
+
+func TestGetWebhookByName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		testRequestMethod(t, r, "GET")
+		if got := r.URL.Query().Get("name"); got != "Test action" {
+			t.Errorf("name filter is %q, want %q", got, "Test action")
+		}
+		fmt.Fprint(w, fmt.Sprintf(`{"count": 1, "next": null, "previous": null, "results": [%s]}`, testWebhookBody))
+	})
+
+	webhook, _, err := client.Webhooks.GetByName("Test action")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testWebhook, webhook) {
+		t.Errorf("returned\n %+v, \nwant\n %+v", webhook, testWebhook)
+	}
+}